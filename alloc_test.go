@@ -0,0 +1,46 @@
+//go:build !race && !rapidval_pool
+
+package rapidval
+
+import "testing"
+
+// TestAllocations enforces allocation ceilings for the hot paths. A failure means a change made
+// validation allocate more; raise a ceiling only deliberately, together with the benchmarks in the README.
+// The race detector allocates on its own, so these tests do not run with -race. The ceilings are
+// those of the default build; the rapidval_pool tag trades allocations for callers of Release.
+func TestAllocations(t *testing.T) {
+	v := New()
+	valid := &testStruct3{Name: "Ada", Email: "ada@example.com", Age: 30}
+	invalid := &testStruct3{Name: "Ada", Email: "ada@example.com", Age: 10}
+	tr := NewTranslator()
+	required := Required("Name", "")
+
+	tests := []struct {
+		name string
+		max  float64
+		fn   func()
+	}{
+		{"Email valid", 0, func() { Email("Email", "ada@example.com") }},
+		{"MinLength valid", 0, func() { MinLength("Name", "Ada", 2) }},
+		{"Between valid", 0, func() { Between("Age", 30, 18, 100) }},
+		{"OneOf valid", 0, func() { OneOf("Role", "admin", "admin", "user") }},
+		{"UUID valid", 0, func() { UUID("ID", "f47ac10b-58cc-4372-a567-0e02b2c3d479") }},
+		{"Alphanumeric valid", 0, func() { Alphanumeric("Code", "SKU42") }},
+		{"PhoneForRegion valid", 0, func() { PhoneForRegion("Phone", "+90 532 123 45 67", "TR") }},
+		{"valid struct", 1, func() { v.Validate(valid) }},
+		{"struct with one error", 6, func() { v.Validate(invalid) }},
+		{"struct with one error released", 6, func() {
+			if errs, ok := v.Validate(invalid).(ValidationErrors); ok {
+				errs.Release()
+			}
+		}},
+		{"Translate", 7, func() { tr.Translate(required) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testing.AllocsPerRun(100, tt.fn); got > tt.max {
+				t.Errorf("%v allocs per run, want at most %v", got, tt.max)
+			}
+		})
+	}
+}
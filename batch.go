@@ -0,0 +1,114 @@
+package rapidval
+
+import (
+	"context"
+	"slices"
+)
+
+// Disposition is the decision of a BatchPolicy about an item of a batch.
+type Disposition int
+
+const (
+	// Accept takes the item, with the warnings of its result if it has any.
+	Accept Disposition = iota
+	// Reject drops the item.
+	Reject
+	// Quarantine sets the item aside for review or a later retry.
+	Quarantine
+)
+
+// BatchPolicy decides what happens to each item of a batch given its validation result, so import
+// jobs can implement business policies on top of validation, e.g. quarantining records whose
+// only errors come from an unavailable remote check.
+type BatchPolicy interface {
+	Decide(index int, r Result) Disposition
+}
+
+// BatchPolicyFunc adapts a function to the BatchPolicy interface.
+type BatchPolicyFunc func(index int, r Result) Disposition
+
+// Decide implements BatchPolicy.
+func (f BatchPolicyFunc) Decide(index int, r Result) Disposition {
+	return f(index, r)
+}
+
+// DefaultBatchPolicy accepts valid items, including those with warnings, and rejects the others.
+var DefaultBatchPolicy BatchPolicy = BatchPolicyFunc(func(index int, r Result) Disposition {
+	if r.Valid() {
+		return Accept
+	}
+	return Reject
+})
+
+// QuarantineOn returns a policy that quarantines invalid items whose errors all have one of the
+// message keys, e.g. MsgUnavailable and MsgTimeout for items worth retrying. Other items are
+// handled like DefaultBatchPolicy.
+func QuarantineOn(keys ...string) BatchPolicy {
+	return BatchPolicyFunc(func(index int, r Result) Disposition {
+		if r.Valid() {
+			return Accept
+		}
+		for _, err := range r.Errors() {
+			if !slices.Contains(keys, err.MessageKey) {
+				return Reject
+			}
+		}
+		return Quarantine
+	})
+}
+
+// BatchItem is an item of a batch with its validation result and disposition.
+type BatchItem[T any] struct {
+	Index       int
+	Value       T
+	Result      Result
+	Disposition Disposition
+}
+
+// BatchResult is the outcome of ValidateBatch.
+type BatchResult[T any] struct {
+	// Items holds every item of the batch, in order.
+	Items []BatchItem[T]
+}
+
+// ValidateBatch validates every item with v and lets policy decide about each of them. A nil v
+// validates with the default settings, and a nil policy is DefaultBatchPolicy.
+func ValidateBatch[T Validateable](ctx context.Context, v *Validator, items []T, policy BatchPolicy) BatchResult[T] {
+	if v == nil {
+		v = &Validator{}
+	}
+	if policy == nil {
+		policy = DefaultBatchPolicy
+	}
+	res := BatchResult[T]{Items: make([]BatchItem[T], len(items))}
+	for i, item := range items {
+		r := v.RunContext(ctx, item)
+		res.Items[i] = BatchItem[T]{Index: i, Value: item, Result: r, Disposition: policy.Decide(i, r)}
+	}
+	return res
+}
+
+// Accepted returns the accepted items.
+func (b BatchResult[T]) Accepted() []BatchItem[T] {
+	return b.group(Accept)
+}
+
+// Rejected returns the rejected items.
+func (b BatchResult[T]) Rejected() []BatchItem[T] {
+	return b.group(Reject)
+}
+
+// Quarantined returns the quarantined items.
+func (b BatchResult[T]) Quarantined() []BatchItem[T] {
+	return b.group(Quarantine)
+}
+
+func (b BatchResult[T]) group(d Disposition) []BatchItem[T] {
+	var items []BatchItem[T]
+	for _, item := range b.Items {
+		if item.Disposition == d {
+			items = append(items, item)
+		}
+	}
+	return items
+}
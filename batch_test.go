@@ -0,0 +1,87 @@
+package rapidval
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type importRecord struct {
+	Email     string
+	Nickname  string
+	available bool
+}
+
+func (r *importRecord) Validations() P {
+	return P{
+		Email("Email", r.Email),
+		Warn(MinLength("Nickname", r.Nickname, 3)),
+		RuleFunc(func(ctx context.Context) *ValidationError {
+			if !r.available {
+				return &ValidationError{Field: "Email", MessageKey: MsgUnavailable}
+			}
+			return nil
+		}),
+	}
+}
+
+func TestValidateBatch(t *testing.T) {
+	records := []*importRecord{
+		{Email: "a@example.com", Nickname: "ada", available: true},
+		{Email: "invalid", Nickname: "bob", available: true},
+		{Email: "c@example.com", Nickname: "c", available: true},
+		{Email: "d@example.com", Nickname: "dan"},
+		{Email: "invalid", Nickname: "eve"},
+	}
+
+	indexes := func(items []BatchItem[*importRecord]) []int {
+		var idx []int
+		for _, item := range items {
+			idx = append(idx, item.Index)
+		}
+		return idx
+	}
+
+	tests := []struct {
+		name            string
+		policy          BatchPolicy
+		wantAccepted    []int
+		wantRejected    []int
+		wantQuarantined []int
+	}{
+		{"default", nil, []int{0, 2}, []int{1, 3, 4}, nil},
+		{"quarantine unavailable", QuarantineOn(MsgUnavailable, MsgTimeout), []int{0, 2}, []int{1, 4}, []int{3}},
+		{"quarantine warnings", BatchPolicyFunc(func(index int, r Result) Disposition {
+			switch {
+			case !r.Valid():
+				return Reject
+			case len(r.Warnings()) > 0:
+				return Quarantine
+			}
+			return Accept
+		}), []int{0}, []int{1, 3, 4}, []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ValidateBatch(context.Background(), nil, records, tt.policy)
+			if len(res.Items) != len(records) {
+				t.Fatalf("len(Items) = %d, want %d", len(res.Items), len(records))
+			}
+			if got := indexes(res.Accepted()); !reflect.DeepEqual(got, tt.wantAccepted) {
+				t.Errorf("Accepted() = %v, want %v", got, tt.wantAccepted)
+			}
+			if got := indexes(res.Rejected()); !reflect.DeepEqual(got, tt.wantRejected) {
+				t.Errorf("Rejected() = %v, want %v", got, tt.wantRejected)
+			}
+			if got := indexes(res.Quarantined()); !reflect.DeepEqual(got, tt.wantQuarantined) {
+				t.Errorf("Quarantined() = %v, want %v", got, tt.wantQuarantined)
+			}
+		})
+	}
+
+	res := ValidateBatch(context.Background(), New(), records, nil)
+	if item := res.Items[2]; item.Value != records[2] || !item.Result.Valid() || len(item.Result.Warnings()) != 1 {
+		t.Errorf("Items[2] = %+v, want the record, valid with one warning", item)
+	}
+}
@@ -0,0 +1,125 @@
+package rapidval
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache stores the outcomes of expensive rules for Cached. A nil result records a passing check.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (result *ValidationError, ok bool)
+	Set(key string, result *ValidationError, ttl time.Duration)
+}
+
+// Cached wraps an expensive rule, typically a Remote rule, so its outcome for the checked value is
+// reused for ttl instead of calling the external service again:
+//
+//	rapidval.Cached(cache, time.Hour, c.Domain, rapidval.Remote("Domain", policy, hasMX(c.Domain)))
+//
+// The cache key combines the rule's name (see Named), the field of Remote rules and key, which
+// identifies the checked value, so Remote rules sharing a check function do not share results
+// across fields. Other rules checking several fields need a distinct name for each of them.
+// Checks that could not be completed, i.e. failed Remote checks and errors with a Cause, are not cached.
+func Cached(cache Cache, ttl time.Duration, key string, rule Rule) Rule {
+	return cachedRule{cache: cache, ttl: ttl, key: ruleName(rule) + "\x00" + ruleField(rule) + "\x00" + key, rule: rule}
+}
+
+// ruleField returns the field checked by rule if it is known before the rule runs.
+func ruleField(rule Rule) string {
+	switch r := rule.(type) {
+	case namedRule:
+		return ruleField(r.Rule)
+	case cachedRule:
+		return ruleField(r.rule)
+	case remoteRule:
+		return r.field
+	}
+	return ""
+}
+
+type cachedRule struct {
+	cache Cache
+	ttl   time.Duration
+	key   string
+	rule  Rule
+}
+
+// Check implements Rule.
+func (c cachedRule) Check(ctx context.Context) *ValidationError {
+	if result, ok := c.cache.Get(c.key); ok {
+		return result.clone()
+	}
+	var err *ValidationError
+	completed := true
+	if r, ok := c.rule.(remoteRule); ok {
+		err, completed = r.run(ctx)
+	} else {
+		err = c.rule.Check(ctx)
+		completed = err == nil || err.Cause == nil
+	}
+	if completed {
+		c.cache.Set(c.key, err.clone(), c.ttl)
+	}
+	return err
+}
+
+// clone returns a copy of ve that is not owned by the error pool, or nil if ve is nil.
+func (ve *ValidationError) clone() *ValidationError {
+	if ve == nil {
+		return nil
+	}
+	c := *ve
+	c.pooled = false
+	c.MessageParams = make(map[string]interface{}, len(ve.MessageParams))
+	for k, v := range ve.MessageParams {
+		c.MessageParams[k] = v
+	}
+	return &c
+}
+
+// NewMemoryCache returns an in-memory Cache. Expired entries are removed lazily.
+func NewMemoryCache() Cache {
+	return &memoryCache{entries: map[string]memoryEntry{}}
+}
+
+type memoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	sets    int
+}
+
+type memoryEntry struct {
+	result  *ValidationError
+	expires time.Time
+}
+
+func (m *memoryCache) Get(key string) (*ValidationError, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return e.result, true
+}
+
+func (m *memoryCache) Set(key string, result *ValidationError, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	// Sweep expired entries from time to time, so values that are never read again do not accumulate.
+	if m.sets++; m.sets%1024 == 0 {
+		for k, e := range m.entries {
+			if now.After(e.expires) {
+				delete(m.entries, k)
+			}
+		}
+	}
+	m.entries[key] = memoryEntry{result: result, expires: now.Add(ttl)}
+}
@@ -0,0 +1,87 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCached(t *testing.T) {
+	cache := NewMemoryCache()
+	calls := 0
+	vatRule := func(vatID string) Rule {
+		return Named("vat_registered", RuleFunc(func(ctx context.Context) *ValidationError {
+			calls++
+			if vatID != "TR1" {
+				return newError("VATID", "validation.vat", vatID)
+			}
+			return nil
+		}))
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := Cached(cache, time.Hour, "TR1", vatRule("TR1")).Check(context.Background()); err != nil {
+			t.Fatalf("Check() = %v, want nil", err)
+		}
+		err := Cached(cache, time.Hour, "XX", vatRule("XX")).Check(context.Background())
+		if err == nil || err.MessageKey != "validation.vat" || err.MessageParams[Value] != "XX" {
+			t.Fatalf("Check() = %v, want validation.vat", err)
+		}
+		// Callers may modify and release the errors they get without affecting the cache.
+		err.Field = "Prefixed.VATID"
+		ValidationErrors{err}.Release()
+	}
+	if calls != 2 {
+		t.Errorf("rule evaluated %d times, want 2", calls)
+	}
+
+	cached, ok := cache.Get("vat_registered\x00\x00XX")
+	if !ok || cached.Field != "VATID" {
+		t.Errorf("cached entry = %+v, want the original error", cached)
+	}
+}
+
+func TestCachedRemoteFields(t *testing.T) {
+	cache := NewMemoryCache()
+	// Closures created by the same function share their name.
+	blocked := func(field string) RemoteFunc {
+		return func(ctx context.Context) (*ValidationError, error) {
+			return &ValidationError{Field: field, MessageKey: MsgUnavailable}, nil
+		}
+	}
+	for _, field := range []string{"Email", "BackupEmail"} {
+		rule := Remote(field, RemotePolicy{}, blocked(field))
+		err := Cached(cache, time.Hour, "a@example.com", rule).Check(context.Background())
+		if err == nil || err.Field != field {
+			t.Errorf("Check() = %+v, want an error for %s", err, field)
+		}
+	}
+}
+
+func TestCachedSkipsFailures(t *testing.T) {
+	cache := NewMemoryCache()
+	calls := 0
+	rule := Remote("VATID", RemotePolicy{OnFailure: FailSkip}, flakyCheck(&calls, 1))
+
+	for i := 0; i < 2; i++ {
+		if err := Cached(cache, time.Hour, "TR1", rule).Check(context.Background()); err != nil {
+			t.Fatalf("Check() = %v, want nil", err)
+		}
+	}
+	// The first, failed and skipped call must not be cached as a pass.
+	if calls != 2 {
+		t.Errorf("check called %d times, want 2", calls)
+	}
+}
+
+func TestMemoryCacheExpiry(t *testing.T) {
+	cache := NewMemoryCache()
+	cache.Set("k", nil, -time.Second)
+	if _, ok := cache.Get("k"); ok {
+		t.Error("Get() returned an expired entry")
+	}
+	cache.Set("k", nil, time.Hour)
+	if _, ok := cache.Get("k"); !ok {
+		t.Error("Get() did not return a live entry")
+	}
+}
@@ -0,0 +1,64 @@
+package rapidval
+
+import "fmt"
+
+// CardExpiry validates the expiry date of a payment card: month must be between 1 and 12 and the card
+// must not have expired, i.e. the end of the given month must not be before Now. Two-digit years,
+// as printed on cards, are read as 20YY.
+func CardExpiry(field string, month, year int) *ValidationError {
+	if month < 1 || month > 12 {
+		err := newError(field, MsgInvalidCardExpiry, month)
+		err.MessageParams[Min] = 1
+		err.MessageParams[Max] = 12
+		return err
+	}
+	if year < 100 {
+		year += 2000
+	}
+	now := Now()
+	if year < now.Year() || year == now.Year() && month < int(now.Month()) {
+		return newError(field, MsgCardExpired, fmt.Sprintf("%02d/%d", month, year))
+	}
+	return nil
+}
+
+// CardBrand identifies a payment card network.
+type CardBrand string
+
+// Card brands with their own security code lengths.
+const (
+	CardBrandUnknown    CardBrand = ""
+	CardBrandVisa       CardBrand = "visa"
+	CardBrandMastercard CardBrand = "mastercard"
+	CardBrandAmex       CardBrand = "amex"
+	CardBrandDiscover   CardBrand = "discover"
+	CardBrandTroy       CardBrand = "troy"
+)
+
+// CVC validates a card security code: 4 digits for American Express, 3 digits for other brands.
+// CardBrandUnknown accepts either length, for forms that ask for the code before the number is known.
+func CVC(field string, value string, brand CardBrand) *ValidationError {
+	min, max := 3, 3
+	switch brand {
+	case CardBrandAmex:
+		min, max = 4, 4
+	case CardBrandUnknown:
+		max = 4
+	}
+	if len(value) < min || len(value) > max || !digits(value) {
+		err := newError(field, MsgInvalidCVC, value)
+		err.MessageParams[Min] = min
+		err.MessageParams[Max] = max
+		return err
+	}
+	return nil
+}
+
+func digits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
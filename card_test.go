@@ -0,0 +1,85 @@
+package rapidval
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCardExpiry(t *testing.T) {
+	defer func(now func() time.Time) { Now = now }(Now)
+	Now = func() time.Time { return time.Date(2025, time.June, 15, 12, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name    string
+		month   int
+		year    int
+		wantKey string
+	}{
+		{"future year", 1, 2026, ""},
+		{"current month", 6, 2025, ""},
+		{"later this year", 12, 2025, ""},
+		{"two-digit year", 7, 25, ""},
+		{"previous month", 5, 2025, MsgCardExpired},
+		{"previous year", 12, 2024, MsgCardExpired},
+		{"two-digit expired", 5, 25, MsgCardExpired},
+		{"month zero", 0, 2026, MsgInvalidCardExpiry},
+		{"month thirteen", 13, 2026, MsgInvalidCardExpiry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CardExpiry("Expiry", tt.month, tt.year)
+			if tt.wantKey == "" {
+				if err != nil {
+					t.Errorf("CardExpiry(%d, %d) = %v, want nil", tt.month, tt.year, err)
+				}
+				return
+			}
+			if err == nil || err.MessageKey != tt.wantKey {
+				t.Errorf("CardExpiry(%d, %d) = %v, want %s", tt.month, tt.year, err, tt.wantKey)
+			}
+		})
+	}
+
+	err := CardExpiry("Expiry", 5, 25)
+	if err.CurrentValue != "05/2025" {
+		t.Errorf("CurrentValue = %v, want 05/2025", err.CurrentValue)
+	}
+}
+
+func TestCVC(t *testing.T) {
+	tests := []struct {
+		value   string
+		brand   CardBrand
+		wantErr bool
+	}{
+		{"123", CardBrandVisa, false},
+		{"1234", CardBrandVisa, true},
+		{"12", CardBrandMastercard, true},
+		{"1234", CardBrandAmex, false},
+		{"123", CardBrandAmex, true},
+		{"123", CardBrandUnknown, false},
+		{"1234", CardBrandUnknown, false},
+		{"12345", CardBrandUnknown, true},
+		{"12a", CardBrandTroy, true},
+		{"", CardBrandDiscover, true},
+	}
+
+	for _, tt := range tests {
+		err := CVC("CVC", tt.value, tt.brand)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CVC(%q, %q) error = %v, wantErr %v", tt.value, tt.brand, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgInvalidCVC {
+			t.Errorf("CVC(%q, %q) message key = %v", tt.value, tt.brand, err.MessageKey)
+		}
+	}
+
+	tr := NewTranslator()
+	if got := tr.Translate(CVC("CVC", "1", CardBrandUnknown)); got != "CVC 3-4 haneli bir güvenlik kodu olmalıdır" {
+		t.Errorf("Translate() = %q", got)
+	}
+	if got := tr.Translate(CVC("CVC", "1", CardBrandAmex)); got != "CVC 4 haneli bir güvenlik kodu olmalıdır" {
+		t.Errorf("Translate() = %q", got)
+	}
+}
@@ -0,0 +1,76 @@
+package rapidval
+
+import "unicode"
+
+// CharsetOption configures Numeric, Alpha and Alphanumeric.
+type CharsetOption func(*charsetConfig)
+
+type charsetConfig struct {
+	unicode bool
+}
+
+// CharsetUnicode accepts the digits and letters of every script instead of ASCII only,
+// e.g. "Çağrı" for Alpha or "٣" (Arabic-Indic three) for Numeric.
+func CharsetUnicode() CharsetOption {
+	return func(c *charsetConfig) {
+		c.unicode = true
+	}
+}
+
+// Numeric validates that value consists of digits only, e.g. a verification code. Signs, decimal
+// points and spaces are rejected, and so is the empty string. Digits are ASCII unless
+// CharsetUnicode is given.
+func Numeric(field string, value string, opts ...CharsetOption) *ValidationError {
+	return charset(field, value, MsgNumeric, isASCIIDigit, unicode.IsDigit, opts)
+}
+
+// Alpha validates that value consists of letters only. The empty string is rejected.
+// Letters are ASCII unless CharsetUnicode is given.
+func Alpha(field string, value string, opts ...CharsetOption) *ValidationError {
+	return charset(field, value, MsgAlpha, isASCIILetter, unicode.IsLetter, opts)
+}
+
+// Alphanumeric validates that value consists of letters and digits only, e.g. a slug segment or
+// a product code. The empty string is rejected. Letters and digits are ASCII unless CharsetUnicode is given.
+func Alphanumeric(field string, value string, opts ...CharsetOption) *ValidationError {
+	return charset(field, value, MsgAlphanumeric,
+		func(r rune) bool { return isASCIILetter(r) || isASCIIDigit(r) },
+		func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) },
+		opts)
+}
+
+func charset(field, value, key string, ascii, all func(rune) bool, opts []CharsetOption) *ValidationError {
+	var cfg charsetConfig
+	if len(opts) > 0 {
+		cfg = charsetOptions(opts)
+	}
+	allowed := ascii
+	if cfg.unicode {
+		allowed = all
+	}
+	if value == "" {
+		return newError(field, key, value)
+	}
+	for _, r := range value {
+		if !allowed(r) {
+			return newError(field, key, value)
+		}
+	}
+	return nil
+}
+
+func charsetOptions(opts []CharsetOption) charsetConfig {
+	var cfg charsetConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isASCIILetter(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
@@ -0,0 +1,48 @@
+package rapidval
+
+import "testing"
+
+func TestCharset(t *testing.T) {
+	unicode := []CharsetOption{CharsetUnicode()}
+
+	tests := []struct {
+		name    string
+		rule    func(field, value string, opts ...CharsetOption) *ValidationError
+		key     string
+		value   string
+		opts    []CharsetOption
+		wantErr bool
+	}{
+		{"numeric", Numeric, MsgNumeric, "0123456789", nil, false},
+		{"numeric sign", Numeric, MsgNumeric, "-12", nil, true},
+		{"numeric decimal", Numeric, MsgNumeric, "1.5", nil, true},
+		{"numeric space", Numeric, MsgNumeric, "12 34", nil, true},
+		{"numeric empty", Numeric, MsgNumeric, "", nil, true},
+		{"numeric arabic-indic", Numeric, MsgNumeric, "١٢٣", nil, true},
+		{"numeric arabic-indic unicode", Numeric, MsgNumeric, "١٢٣", unicode, false},
+		{"alpha", Alpha, MsgAlpha, "Ada", nil, false},
+		{"alpha digit", Alpha, MsgAlpha, "Ada1", nil, true},
+		{"alpha turkish", Alpha, MsgAlpha, "Çağrı", nil, true},
+		{"alpha turkish unicode", Alpha, MsgAlpha, "Çağrı", unicode, false},
+		{"alpha hyphen unicode", Alpha, MsgAlpha, "Jean-Luc", unicode, true},
+		{"alpha empty", Alpha, MsgAlpha, "", unicode, true},
+		{"alphanumeric", Alphanumeric, MsgAlphanumeric, "SKU42", nil, false},
+		{"alphanumeric slug", Alphanumeric, MsgAlphanumeric, "my-slug", nil, true},
+		{"alphanumeric underscore", Alphanumeric, MsgAlphanumeric, "a_b", nil, true},
+		{"alphanumeric unicode", Alphanumeric, MsgAlphanumeric, "Ürün42", unicode, false},
+		{"alphanumeric unicode ascii mode", Alphanumeric, MsgAlphanumeric, "Ürün42", nil, true},
+		{"alphanumeric empty", Alphanumeric, MsgAlphanumeric, "", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule("Code", tt.value, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err.MessageKey != tt.key {
+				t.Errorf("MessageKey = %v, want %v", err.MessageKey, tt.key)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package rapidval
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Class is a character class or substring that ContainsAny and ContainsAll look for.
+type Class struct {
+	// Name describes the class in messages, e.g. "uppercase".
+	Name     string
+	contains func(s string) bool
+}
+
+// Character classes for ContainsAny and ContainsAll.
+var (
+	ClassUpper  = runeClass("uppercase", unicode.IsUpper)
+	ClassLower  = runeClass("lowercase", unicode.IsLower)
+	ClassLetter = runeClass("letter", unicode.IsLetter)
+	ClassDigit  = runeClass("digit", unicode.IsDigit)
+	ClassSpace  = runeClass("space", unicode.IsSpace)
+	ClassSymbol = runeClass("symbol", func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) })
+)
+
+func runeClass(name string, f func(rune) bool) Class {
+	return Class{Name: name, contains: func(s string) bool { return strings.IndexFunc(s, f) >= 0 }}
+}
+
+// AnyChar returns a class matching any of the characters in chars, e.g. AnyChar("!@#$").
+func AnyChar(chars string) Class {
+	return Class{Name: chars, contains: func(s string) bool { return strings.ContainsAny(s, chars) }}
+}
+
+// Substring returns a class matching the substring sub.
+func Substring(sub string) Class {
+	return Class{Name: sub, contains: func(s string) bool { return strings.Contains(s, sub) }}
+}
+
+// ContainsAny validates that a string contains at least one of the given classes.
+// The names of the classes are available as the Allowed param.
+func ContainsAny(field string, value string, classes ...Class) *ValidationError {
+	for _, c := range classes {
+		if c.contains(value) {
+			return nil
+		}
+	}
+	err := newError(field, MsgContainsAny, value)
+	err.MessageParams[Allowed] = classNames(classes)
+	return err
+}
+
+// ContainsAll validates that a string contains every one of the given classes, e.g. for password policies:
+//
+//	rapidval.ContainsAll("Password", u.Password, rapidval.ClassUpper, rapidval.ClassLower, rapidval.ClassDigit)
+//
+// The names of the classes are available as the Allowed param and those not found as the Missing param.
+func ContainsAll(field string, value string, classes ...Class) *ValidationError {
+	var missing []Class
+	for _, c := range classes {
+		if !c.contains(value) {
+			missing = append(missing, c)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	err := newError(field, MsgContainsAll, value)
+	err.MessageParams[Allowed] = classNames(classes)
+	err.MessageParams[Missing] = classNames(missing)
+	return err
+}
+
+func classNames(classes []Class) []string {
+	names := make([]string, len(classes))
+	for i, c := range classes {
+		names[i] = c.Name
+	}
+	return names
+}
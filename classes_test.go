@@ -0,0 +1,77 @@
+package rapidval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestContainsAny(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		classes []Class
+		wantErr bool
+	}{
+		{"digit", "abc1", []Class{ClassDigit}, false},
+		{"symbol or digit", "abc!", []Class{ClassDigit, ClassSymbol}, false},
+		{"none", "abc", []Class{ClassDigit, ClassSymbol}, true},
+		{"unicode upper", "çĞ", []Class{ClassUpper}, false},
+		{"any char", "a-b", []Class{AnyChar("_-")}, false},
+		{"substring", "order-TR-1", []Class{Substring("-TR-")}, false},
+		{"missing substring", "order-DE-1", []Class{Substring("-TR-")}, true},
+		{"empty value", "", []Class{ClassLetter}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ContainsAny("code", tt.value, tt.classes...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ContainsAny() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && err.MessageKey != MsgContainsAny {
+				t.Errorf("ContainsAny() message key = %v, want %v", err.MessageKey, MsgContainsAny)
+			}
+		})
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	classes := []Class{ClassUpper, ClassLower, ClassDigit, ClassSymbol}
+	tests := []struct {
+		name        string
+		value       string
+		wantMissing []string
+	}{
+		{"all", "Passw0rd!", nil},
+		{"missing symbol", "Passw0rd", []string{"symbol"}},
+		{"missing several", "password", []string{"uppercase", "digit", "symbol"}},
+		{"space is not a symbol", "Pass w0rd", []string{"symbol"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ContainsAll("password", tt.value, classes...)
+			if (err != nil) != (tt.wantMissing != nil) {
+				t.Fatalf("ContainsAll() error = %v, want missing %v", err, tt.wantMissing)
+			}
+			if err == nil {
+				return
+			}
+			if err.MessageKey != MsgContainsAll {
+				t.Errorf("ContainsAll() message key = %v, want %v", err.MessageKey, MsgContainsAll)
+			}
+			if got := err.MessageParams[Missing]; !reflect.DeepEqual(got, tt.wantMissing) {
+				t.Errorf("Missing = %v, want %v", got, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestContainsAllTranslation(t *testing.T) {
+	err := ContainsAll("Şifre", "sifre", ClassUpper, ClassDigit)
+	want := "Şifre şunları da içermelidir: [uppercase digit]"
+	if got := NewTranslator().Translate(err); got != want {
+		t.Errorf("Translate() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,7 @@
+package rapidval
+
+import "time"
+
+// Now returns the current time used by rules that compare against the present, such as CardExpiry.
+// It defaults to time.Now; tests can replace it to get deterministic results.
+var Now = time.Now
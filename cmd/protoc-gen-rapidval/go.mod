@@ -0,0 +1,5 @@
+module github.com/9ssi7/rapidval/cmd/protoc-gen-rapidval
+
+go 1.23.0
+
+require google.golang.org/protobuf v1.34.2
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestParseAnnotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments string
+		want     string
+		wantOK   bool
+	}{
+		{"single line", " @rapidval: required,min=2\n", "required,min=2", true},
+		{"among other comments", " The user's name.\n @rapidval:required\n Shown in the UI.\n", "required", true},
+		{"no annotation", " The user's name.\n", "", false},
+		{"empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseAnnotation(tt.comments)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("parseAnnotation() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestOneOfArgs(t *testing.T) {
+	tests := []struct {
+		name  string
+		param string
+		kind  protoreflect.Kind
+		want  string
+	}{
+		{"strings", "admin editor", protoreflect.StringKind, `"admin", "editor"`},
+		{"ints", "1 2 3", protoreflect.Int32Kind, "1, 2, 3"},
+		{"negative uint", "1 -2", protoreflect.Uint32Kind, ""},
+		{"floats", "0.5 1", protoreflect.DoubleKind, "0.5, 1"},
+		{"words for int", "read write", protoreflect.Int64Kind, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := oneOfArgs(tt.param, tt.kind); got != tt.want {
+				t.Errorf("oneOfArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
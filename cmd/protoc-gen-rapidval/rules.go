@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldRules translates the annotation of a field into rapidval rule expressions.
+// Rules without a rapidval equivalent are emitted as TODO comments so nothing is silently dropped.
+func fieldRules(f *protogen.Field, tag string, ident func(protogen.GoIdent) string) []string {
+	name := strconv.Quote(string(f.Desc.Name()))
+	getter := fmt.Sprintf("x.Get%s()", f.GoName)
+	rv := func(fn string) string { return ident(rapidvalPackage.Ident(fn)) }
+	rvp := func(fn string) string { return ident(rapidvalProtoPackage.Ident(fn)) }
+
+	kind := f.Desc.Kind()
+	isString := kind == protoreflect.StringKind && !f.Desc.IsList() && !f.Desc.IsMap()
+	isNumber := (kind == protoreflect.Int32Kind || kind == protoreflect.Sint32Kind || kind == protoreflect.Sfixed32Kind ||
+		kind == protoreflect.Int64Kind || kind == protoreflect.Sint64Kind || kind == protoreflect.Sfixed64Kind ||
+		kind == protoreflect.Uint32Kind || kind == protoreflect.Fixed32Kind ||
+		kind == protoreflect.Uint64Kind || kind == protoreflect.Fixed64Kind ||
+		kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind) &&
+		!f.Desc.IsList() && !f.Desc.IsMap()
+
+	var lines []string
+	var min, max string
+	for _, part := range strings.Split(tag, ",") {
+		rule, param, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch {
+		case rule == "":
+		case rule == "required" && f.Oneof == nil && f.Desc.HasPresence():
+			lines = append(lines, fmt.Sprintf("%s(%s, x.%s != nil)", rvp("Present"), name, f.GoName))
+		case rule == "required" && (f.Desc.IsList() || f.Desc.IsMap()):
+			lines = append(lines, fmt.Sprintf("%s(%s, len(x.%s) > 0)", rvp("Present"), name, f.GoName))
+		case rule == "required" && f.Oneof == nil:
+			lines = append(lines, fmt.Sprintf("%s(%s, %s)", rv("Required"), name, getter))
+		case rule == "email" && isString:
+			lines = append(lines, fmt.Sprintf("%s(%s, %s)", rv("Email"), name, getter))
+		case (rule == "min" || rule == "gte") && isString:
+			lines = append(lines, fmt.Sprintf("%s(%s, %s, %s)", rv("MinLength"), name, getter, param))
+		case (rule == "max" || rule == "lte") && isString:
+			lines = append(lines, fmt.Sprintf("%s(%s, %s, %s)", rv("MaxLength"), name, getter, param))
+		case rule == "oneof" && isString && param != "":
+			lines = append(lines, fmt.Sprintf("%s(%s, %s, %s)", rv("OneOf"), name, getter, oneOfArgs(param, kind)))
+		case rule == "oneof" && isNumber && param != "" && oneOfArgs(param, kind) != "":
+			lines = append(lines, fmt.Sprintf("%s(%s, %s, %s)", rv("In"), name, getter, oneOfArgs(param, kind)))
+		case (rule == "min" || rule == "gte") && isNumber:
+			min = param
+		case (rule == "max" || rule == "lte") && isNumber:
+			max = param
+		default:
+			lines = append(lines, fmt.Sprintf("// TODO(protoc-gen-rapidval): %s: no rapidval equivalent for %q", f.Desc.Name(), part))
+		}
+	}
+
+	switch {
+	case min != "" && max != "":
+		lines = append(lines, fmt.Sprintf("%s(%s, %s, %s, %s)", rv("Between"), name, getter, min, max))
+	case min != "":
+		lines = append(lines, fmt.Sprintf("// TODO(protoc-gen-rapidval): %s: no rapidval equivalent for \"min=%s\"", f.Desc.Name(), min))
+	case max != "":
+		lines = append(lines, fmt.Sprintf("// TODO(protoc-gen-rapidval): %s: no rapidval equivalent for \"max=%s\"", f.Desc.Name(), max))
+	}
+	return lines
+}
+
+// oneOfArgs formats the space-separated values of a oneof rule as Go arguments for a field of kind:
+// quoted for strings, and as-is for numbers. It returns "" if a value is not a valid number for the kind.
+func oneOfArgs(param string, kind protoreflect.Kind) string {
+	values := strings.Fields(param)
+	for i, v := range values {
+		var err error
+		switch kind {
+		case protoreflect.StringKind:
+			values[i] = strconv.Quote(v)
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			_, err = strconv.ParseFloat(v, 64)
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			_, err = strconv.ParseUint(v, 10, 64)
+		default:
+			_, err = strconv.ParseInt(v, 10, 64)
+		}
+		if err != nil {
+			return ""
+		}
+	}
+	return strings.Join(values, ", ")
+}
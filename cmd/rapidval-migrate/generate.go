@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// generate returns the source of a file declaring Validations methods for the tagged structs in src.
+// Types in existing, which holds the types of the package that already have a Validations method,
+// and types with a Validations method in src itself are skipped.
+// It returns nil if src declares no tagged structs that need a method.
+func generate(filename string, src []byte, existing map[string]bool) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	own := validationsMethods(file)
+
+	var body bytes.Buffer
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || ts.TypeParams != nil || existing[ts.Name.Name] || own[ts.Name.Name] {
+				continue
+			}
+			writeMethod(&body, ts.Name.Name, st)
+		}
+	}
+	if body.Len() == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by rapidval-migrate from %s. Review before committing.\n\n", baseName(filename))
+	fmt.Fprintf(&buf, "package %s\n\n", file.Name.Name)
+	fmt.Fprintf(&buf, "import \"github.com/9ssi7/rapidval\"\n")
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// validationsMethods returns the receiver types of the Validations methods declared in file.
+func validationsMethods(file *ast.File) map[string]bool {
+	types := map[string]bool{}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Name.Name != "Validations" {
+			continue
+		}
+		types[receiverType(fn.Recv.List[0].Type)] = true
+	}
+	return types
+}
+
+func writeMethod(buf *bytes.Buffer, typeName string, st *ast.StructType) {
+	var lines []string
+	for _, f := range st.Fields.List {
+		if f.Tag == nil || len(f.Names) == 0 {
+			continue
+		}
+		tagValue, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			continue
+		}
+		tag, ok := reflect.StructTag(tagValue).Lookup("validate")
+		if !ok || tag == "" || tag == "-" {
+			continue
+		}
+		for _, name := range f.Names {
+			if !name.IsExported() {
+				continue
+			}
+			lines = append(lines, fieldRules(name.Name, f.Type, tag)...)
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	recv := receiverName(typeName)
+	fmt.Fprintf(buf, "\nfunc (%s *%s) Validations() rapidval.P {\n", recv, typeName)
+	fmt.Fprintf(buf, "\treturn rapidval.P{\n")
+	for _, line := range lines {
+		if strings.HasPrefix(line, "//") {
+			fmt.Fprintf(buf, "\t\t%s\n", line)
+			continue
+		}
+		fmt.Fprintf(buf, "\t\t%s,\n", strings.ReplaceAll(line, "$", recv))
+	}
+	fmt.Fprintf(buf, "\t}\n}\n")
+}
+
+// fieldRules translates a validate tag into rapidval rule calls.
+// "$" is used as a placeholder for the receiver name.
+func fieldRules(field string, typ ast.Expr, tag string) []string {
+	kind := exprKind(typ)
+	ref := fmt.Sprintf("$.%s", field)
+	q := strconv.Quote(field)
+
+	params := map[string]string{}
+	var lines []string
+	var omitempty bool
+	for _, part := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch {
+		case name == "":
+		case name == "omitempty":
+			omitempty = true
+		case name == "required" && kind != "other":
+			lines = append(lines, fmt.Sprintf("rapidval.Required(%s, %s)", q, ref))
+		case name == "email" && kind == "string":
+			lines = append(lines, fmt.Sprintf("rapidval.Email(%s, %s)", q, ref))
+		case (name == "min" || name == "gte") && kind == "string":
+			lines = append(lines, fmt.Sprintf("rapidval.MinRunes(%s, %s, %s)", q, ref, param))
+		case (name == "max" || name == "lte") && kind == "string":
+			lines = append(lines, fmt.Sprintf("rapidval.MaxRunes(%s, %s, %s)", q, ref, param))
+		case name == "oneof" && kind == "string" && param != "":
+			lines = append(lines, fmt.Sprintf("rapidval.OneOf(%s, %s, %s)", q, ref, oneOfArgs(param, true)))
+		case name == "oneof" && kind == "int" && param != "" && oneOfArgs(param, false) != "":
+			lines = append(lines, fmt.Sprintf("rapidval.In(%s, %s, %s)", q, ref, oneOfArgs(param, false)))
+		case (name == "min" || name == "gte") && kind == "int":
+			params["min"] = param
+		case (name == "max" || name == "lte") && kind == "int":
+			params["max"] = param
+		default:
+			lines = append(lines, fmt.Sprintf("// TODO(rapidval-migrate): %s: no rapidval equivalent for %q", field, part))
+		}
+	}
+
+	switch {
+	case params["min"] != "" && params["max"] != "":
+		lines = append(lines, fmt.Sprintf("rapidval.Between(%s, %s, %s, %s)", q, ref, params["min"], params["max"]))
+	case params["min"] != "":
+		lines = append(lines, fmt.Sprintf("// TODO(rapidval-migrate): %s: no rapidval equivalent for \"min=%s\"", field, params["min"]))
+	case params["max"] != "":
+		lines = append(lines, fmt.Sprintf("// TODO(rapidval-migrate): %s: no rapidval equivalent for \"max=%s\"", field, params["max"]))
+	}
+	if omitempty {
+		return omitEmpty(field, typ, ref, lines)
+	}
+	return lines
+}
+
+// omitEmpty wraps the rules of an omitempty field in a When that skips them for the zero value.
+// If the zero value of the field's type cannot be told from its declaration, the rules are
+// kept unconditional and a TODO comment is added instead.
+func omitEmpty(field string, typ ast.Expr, ref string, lines []string) []string {
+	var rules, comments []string
+	for _, line := range lines {
+		if strings.HasPrefix(line, "//") {
+			comments = append(comments, line)
+		} else {
+			rules = append(rules, line)
+		}
+	}
+	if len(rules) == 0 {
+		return lines
+	}
+	cond := nonZero(typ, ref)
+	if cond == "" {
+		return append(lines, fmt.Sprintf("// TODO(rapidval-migrate): %s: the rules above also apply to zero values, unlike \"omitempty\"", field))
+	}
+	return append([]string{fmt.Sprintf("rapidval.When(%s, %s)", cond, strings.Join(rules, ", "))}, comments...)
+}
+
+// nonZero returns the condition that ref, of type typ, is not the zero value, or "" if it is not known.
+func nonZero(typ ast.Expr, ref string) string {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return ref + ` != ""`
+		case "bool":
+			return ref
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+			return ref + " != 0"
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok && pkg.Name == "time" && t.Sel.Name == "Time" {
+			return "!" + ref + ".IsZero()"
+		}
+	case *ast.StarExpr, *ast.MapType, *ast.InterfaceType:
+		return ref + " != nil"
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return ref + " != nil"
+		}
+	}
+	return ""
+}
+
+// oneOfArgs formats the space-separated values of a oneof tag as Go arguments, quoted for strings.
+// It returns "" if a value is not an integer and quote is false.
+func oneOfArgs(param string, quote bool) string {
+	values := strings.Fields(param)
+	for i, v := range values {
+		if quote {
+			values[i] = strconv.Quote(v)
+		} else if _, err := strconv.Atoi(v); err != nil {
+			return ""
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+// exprKind classifies a field type into the categories the generator knows how to handle.
+func exprKind(typ ast.Expr) string {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return "string"
+		case "int":
+			return "int"
+		case "bool", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+			return "scalar"
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok && pkg.Name == "time" && t.Sel.Name == "Time" {
+			return "scalar"
+		}
+	}
+	return "other"
+}
+
+func receiverType(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverType(t.X)
+	case *ast.Ident:
+		return t.Name
+	case *ast.IndexExpr:
+		return receiverType(t.X)
+	}
+	return ""
+}
+
+func receiverName(typeName string) string {
+	for _, r := range typeName {
+		return string(unicode.ToLower(r))
+	}
+	return "v"
+}
+
+func baseName(path string) string {
+	if i := strings.LastIndexAny(path, `/\`); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const source = `package api
+
+import "time"
+
+type CreateUser struct {
+	Name     string    ` + "`json:\"name\" validate:\"required,min=2,max=50\"`" + `
+	Email    string    ` + "`validate:\"required,email\"`" + `
+	Age      int       ` + "`validate:\"gte=18,lte=100\"`" + `
+	Role     string    ` + "`validate:\"oneof=admin user\"`" + `
+	Level    int       ` + "`validate:\"oneof=1 2 3\"`" + `
+	Scope    int       ` + "`validate:\"oneof=read write\"`" + `
+	Birthday time.Time ` + "`validate:\"required\"`" + `
+	Note     string
+	Website  string    ` + "`validate:\"omitempty,email,max=100\"`" + `
+	Score    int       ` + "`validate:\"omitempty,min=1,max=5\"`" + `
+	Expires  time.Time ` + "`validate:\"omitempty,required\"`" + `
+}
+
+type Existing struct {
+	Name string ` + "`validate:\"required\"`" + `
+}
+
+func (e *Existing) Validations() rapidval.P { return nil }
+`
+
+func TestGenerate(t *testing.T) {
+	out, err := generate("api/user.go", []byte(source), nil)
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	got := string(out)
+
+	for _, want := range []string{
+		"package api",
+		`import "github.com/9ssi7/rapidval"`,
+		"func (c *CreateUser) Validations() rapidval.P {",
+		`rapidval.Required("Name", c.Name),`,
+		`rapidval.MinRunes("Name", c.Name, 2),`,
+		`rapidval.MaxRunes("Name", c.Name, 50),`,
+		`rapidval.Email("Email", c.Email),`,
+		`rapidval.Between("Age", c.Age, 18, 100),`,
+		`rapidval.Required("Birthday", c.Birthday),`,
+		`rapidval.OneOf("Role", c.Role, "admin", "user"),`,
+		`rapidval.In("Level", c.Level, 1, 2, 3),`,
+		`// TODO(rapidval-migrate): Scope: no rapidval equivalent for "oneof=read write"`,
+		`rapidval.When(c.Website != "", rapidval.Email("Website", c.Website), rapidval.MaxRunes("Website", c.Website, 100)),`,
+		`rapidval.When(c.Score != 0, rapidval.Between("Score", c.Score, 1, 5)),`,
+		`rapidval.When(!c.Expires.IsZero(), rapidval.Required("Expires", c.Expires)),`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "Existing") {
+		t.Errorf("generated code should skip types with a Validations method:\n%s", got)
+	}
+}
+
+func TestOmitEmptyUnknownZero(t *testing.T) {
+	lines := omitEmpty("Status", ast.NewIdent("Status"), "$.Status", []string{`rapidval.Required("Status", $.Status)`})
+	want := []string{
+		`rapidval.Required("Status", $.Status)`,
+		`// TODO(rapidval-migrate): Status: the rules above also apply to zero values, unlike "omitempty"`,
+	}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("omitEmpty() = %q, want %q", lines, want)
+	}
+}
+
+func TestGenerateNoTags(t *testing.T) {
+	out, err := generate("plain.go", []byte("package plain\n\ntype T struct{ Name string }\n"), nil)
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if out != nil {
+		t.Errorf("generate() = %s, want nil", out)
+	}
+}
+
+func TestGenerateMethodInOtherFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"user.go":             "package api\n\ntype User struct {\n\tName string `validate:\"required\"`\n}\n\ntype Team struct {\n\tName string `validate:\"required\"`\n}\n",
+		"user_rules.go":       "package api\n\nfunc (u *User) Validations() rapidval.P { return nil }\n",
+		"user_test.go":        "package api\n\nfunc (t *Team) Validations() rapidval.P { return nil }\n",
+		"team_validations.go": "package api\n\nfunc (t *Team) Validations() rapidval.P { return nil }\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	existing, err := packageMethods(dir, "_validations.go")
+	if err != nil {
+		t.Fatalf("packageMethods() error = %v", err)
+	}
+	if want := map[string]bool{"User": true}; !reflect.DeepEqual(existing, want) {
+		t.Errorf("packageMethods() = %v, want %v", existing, want)
+	}
+
+	out, err := generate("user.go", []byte(files["user.go"]), existing)
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if got := string(out); strings.Contains(got, "*User)") || !strings.Contains(got, "func (t *Team) Validations() rapidval.P {") {
+		t.Errorf("generate() should only declare Team.Validations:\n%s", got)
+	}
+}
@@ -0,0 +1,120 @@
+// Command rapidval-migrate generates rapidval Validations methods from go-playground/validator struct tags.
+//
+// It scans the given directories (recursively, default ".") for structs whose fields carry
+// `validate:"..."` tags and writes a <file>_validations.go next to each source file
+// containing an equivalent `Validations() rapidval.P` method per struct:
+//
+//	rapidval-migrate ./...
+//	rapidval-migrate -n ./internal/api
+//
+// Tags without an explicit rapidval equivalent are kept as TODO comments in the generated code
+// so nothing is silently dropped. Structs that already declare a Validations method anywhere in
+// their package are skipped. String min/max tags become MinRunes and MaxRunes, which count characters
+// like validator/v10, and the rules of omitempty fields are wrapped in rapidval.When so that zero
+// values skip them.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	dryRun := flag.Bool("n", false, "print generated code to stdout instead of writing files")
+	suffix := flag.String("suffix", "_validations.go", "suffix of the generated files")
+	flag.Parse()
+
+	roots := flag.Args()
+	if len(roots) == 0 {
+		roots = []string{"."}
+	}
+
+	// existing caches the types with a Validations method per package directory.
+	existing := map[string]map[string]bool{}
+	for _, root := range roots {
+		root = strings.TrimSuffix(root, "/...")
+		if err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				name := d.Name()
+				if path != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, *suffix) {
+				return nil
+			}
+			dir := filepath.Dir(path)
+			if existing[dir] == nil {
+				methods, err := packageMethods(dir, *suffix)
+				if err != nil {
+					return err
+				}
+				existing[dir] = methods
+			}
+			return migrateFile(path, *suffix, *dryRun, existing[dir])
+		}); err != nil {
+			fmt.Fprintln(os.Stderr, "rapidval-migrate:", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// packageMethods returns the types of the package in dir that declare a Validations method.
+// Test files and previously generated files, which are regenerated, are not considered.
+func packageMethods(dir, suffix string) (map[string]bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	types := map[string]bool{}
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, suffix) {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for t := range validationsMethods(file) {
+			types[t] = true
+		}
+	}
+	return types, nil
+}
+
+func migrateFile(path, suffix string, dryRun bool, existing map[string]bool) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	out, err := generate(path, src, existing)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+
+	if dryRun {
+		fmt.Printf("// %s\n%s\n", path, out)
+		return nil
+	}
+	target := strings.TrimSuffix(path, ".go") + suffix
+	if err := os.WriteFile(target, out, 0o644); err != nil {
+		return err
+	}
+	fmt.Println(target)
+	return nil
+}
@@ -0,0 +1,194 @@
+package rapidval
+
+// gtinLengths are the lengths of the GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) and GTIN-14 formats.
+var gtinLengths = []int{8, 12, 13, 14}
+
+// GTIN validates a GTIN barcode number (EAN-8, UPC-A, EAN-13 or GTIN-14) including its check digit.
+// lengths restricts the accepted formats, e.g. GTIN("Barcode", code, 13) for EAN-13 only;
+// without lengths all four formats are accepted.
+func GTIN(field string, value string, lengths ...int) *ValidationError {
+	if len(lengths) == 0 {
+		lengths = gtinLengths
+	}
+	if !hasLength(value, lengths) || !digits(value) || !gtinChecksum(value) {
+		err := newError(field, MsgInvalidGTIN, value)
+		err.MessageParams[Allowed] = lengths
+		return err
+	}
+	return nil
+}
+
+func hasLength(value string, lengths []int) bool {
+	for _, n := range lengths {
+		if len(value) == n {
+			return true
+		}
+	}
+	return false
+}
+
+// gtinChecksum reports whether the last digit of a GTIN matches the weighted mod 10 sum of the others,
+// weighting digits 3 and 1 alternately from the right.
+func gtinChecksum(value string) bool {
+	sum := 0
+	for i := len(value) - 2; i >= 0; i-- {
+		d := int(value[i] - '0')
+		if (len(value)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(value[len(value)-1]-'0')
+}
+
+// ISBN10 validates a 10-digit ISBN including its mod 11 check digit, which may be 'X'.
+// Hyphens and spaces between the groups are ignored.
+func ISBN10(field string, value string) *ValidationError {
+	if !mod11(stripSeparators(value), 10) {
+		return newError(field, MsgInvalidISBN10, value)
+	}
+	return nil
+}
+
+// ISBN13 validates a 13-digit ISBN: a 978 or 979 prefix followed by digits with a valid EAN-13 check digit.
+// Hyphens and spaces between the groups are ignored.
+func ISBN13(field string, value string) *ValidationError {
+	s := stripSeparators(value)
+	if len(s) != 13 || s[:3] != "978" && s[:3] != "979" || !digits(s) || !gtinChecksum(s) {
+		return newError(field, MsgInvalidISBN13, value)
+	}
+	return nil
+}
+
+// ISSN validates an 8-digit ISSN such as 0317-8471, including its mod 11 check digit, which may be 'X'.
+func ISSN(field string, value string) *ValidationError {
+	if !mod11(stripSeparators(value), 8) {
+		return newError(field, MsgInvalidISSN, value)
+	}
+	return nil
+}
+
+// stripSeparators removes the hyphens and spaces used to group the digits of a code.
+// It only allocates when value contains separators.
+func stripSeparators(value string) string {
+	for i := 0; i < len(value); i++ {
+		if value[i] == '-' || value[i] == ' ' {
+			b := make([]byte, 0, len(value))
+			for j := 0; j < len(value); j++ {
+				if value[j] != '-' && value[j] != ' ' {
+					b = append(b, value[j])
+				}
+			}
+			return string(b)
+		}
+	}
+	return value
+}
+
+// mod11 reports whether s is n characters long, all digits except a possible trailing 'X' (10),
+// and the sum of its digits weighted n down to 1 is divisible by 11, as used by ISBN-10 and ISSN.
+func mod11(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	sum := 0
+	for i := 0; i < n; i++ {
+		c := s[i]
+		var d int
+		switch {
+		case c >= '0' && c <= '9':
+			d = int(c - '0')
+		case (c == 'X' || c == 'x') && i == n-1:
+			d = 10
+		default:
+			return false
+		}
+		sum += d * (n - i)
+	}
+	return sum%11 == 0
+}
+
+// vinWeights are the position weights of the VIN check digit calculation; the check digit itself is at index 8.
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// VIN validates a 17-character vehicle identification number: letters other than I, O and Q and digits,
+// with the check digit at position 9 matching the ISO 3779 transliterated, weighted mod 11 sum.
+func VIN(field string, value string) *ValidationError {
+	if len(value) != 17 {
+		return newError(field, MsgInvalidVIN, value)
+	}
+	sum := 0
+	for i := 0; i < 17; i++ {
+		d, ok := vinValue(value[i])
+		if !ok {
+			return newError(field, MsgInvalidVIN, value)
+		}
+		sum += d * vinWeights[i]
+	}
+	check := byte('0' + sum%11)
+	if sum%11 == 10 {
+		check = 'X'
+	}
+	if upper(value[8]) != check {
+		return newError(field, MsgInvalidVIN, value)
+	}
+	return nil
+}
+
+// vinValue transliterates a VIN character to its numeric value. I, O and Q are not allowed.
+func vinValue(c byte) (int, bool) {
+	c = upper(c)
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1, true
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1, true
+	case c == 'P':
+		return 7, true
+	case c == 'R':
+		return 9, true
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2, true
+	}
+	return 0, false
+}
+
+func upper(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
+
+// NumericCode validates a code of exactly length digits, such as a one-time password or a PIN.
+// The check inspects every byte regardless of where the first invalid one is, so its timing depends
+// only on the length of value. Wrap it in Sensitive to keep the code out of errors and logs.
+func NumericCode(field string, value string, length int) *ValidationError {
+	bad := len(value) ^ length
+	for i := 0; i < len(value); i++ {
+		c := int(value[i])
+		bad |= ((c - '0') | ('9' - c)) >> 8 // negative, and so non-zero, outside '0'-'9'
+	}
+	if bad != 0 {
+		err := newError(field, MsgInvalidNumericCode, value)
+		err.MessageParams[Length] = length
+		return err
+	}
+	return nil
+}
+
+// Checksum validates value with a domain-specific check, such as the check digit of a membership or
+// loyalty card number, and reports failures with key, or MsgInvalidChecksum if key is empty.
+//
+//	rapidval.Checksum("CardNumber", c.Number, loyalty.Verify, "validation.loyalty_card")
+func Checksum(field string, value string, verify func(string) bool, key string) *ValidationError {
+	if verify(value) {
+		return nil
+	}
+	if key == "" {
+		key = MsgInvalidChecksum
+	}
+	return newError(field, key, value)
+}
@@ -0,0 +1,162 @@
+package rapidval
+
+import "testing"
+
+func TestGTIN(t *testing.T) {
+	tests := []struct {
+		value   string
+		lengths []int
+		wantErr bool
+	}{
+		{"96385074", nil, false},
+		{"036000291452", nil, false},
+		{"4006381333931", nil, false},
+		{"10012345678902", nil, false},
+		{"4006381333932", nil, true},
+		{"400638133393", nil, true},
+		{"400638133393a", nil, true},
+		{"", nil, true},
+		{"4006381333931", []int{13}, false},
+		{"036000291452", []int{13}, true},
+	}
+
+	for _, tt := range tests {
+		err := GTIN("Barcode", tt.value, tt.lengths...)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("GTIN(%q, %v) error = %v, wantErr %v", tt.value, tt.lengths, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgInvalidGTIN {
+			t.Errorf("GTIN(%q) message key = %v", tt.value, err.MessageKey)
+		}
+	}
+}
+
+func TestISBNAndISSN(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    func(field, value string) *ValidationError
+		value   string
+		wantKey string
+	}{
+		{"isbn10", ISBN10, "0306406152", ""},
+		{"isbn10 hyphens", ISBN10, "0-306-40615-2", ""},
+		{"isbn10 check x", ISBN10, "080442957X", ""},
+		{"isbn10 bad check", ISBN10, "0306406153", MsgInvalidISBN10},
+		{"isbn10 x not last", ISBN10, "03064X6152", MsgInvalidISBN10},
+		{"isbn10 short", ISBN10, "030640615", MsgInvalidISBN10},
+		{"isbn13", ISBN13, "9780306406157", ""},
+		{"isbn13 hyphens", ISBN13, "978-0-306-40615-7", ""},
+		{"isbn13 bad check", ISBN13, "9780306406158", MsgInvalidISBN13},
+		{"isbn13 not bookland", ISBN13, "4006381333931", MsgInvalidISBN13},
+		{"issn", ISSN, "0317-8471", ""},
+		{"issn check x", ISSN, "2434-561X", ""},
+		{"issn bad check", ISSN, "0317-8472", MsgInvalidISSN},
+		{"issn empty", ISSN, "", MsgInvalidISSN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule("Code", tt.value)
+			if tt.wantKey == "" {
+				if err != nil {
+					t.Errorf("%s(%q) = %v, want nil", tt.name, tt.value, err)
+				}
+				return
+			}
+			if err == nil || err.MessageKey != tt.wantKey {
+				t.Errorf("%s(%q) = %v, want %s", tt.name, tt.value, err, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestVIN(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"1M8GDM9AXKP042788", false},
+		{"1HGCM82633A004352", false},
+		{"1hgcm82633a004352", false},
+		{"1HGCM82643A004352", true},
+		{"1HGCM82633A00435", true},
+		{"1HGCM8263IA004352", true},
+		{"1HGCM82633A0O4352", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		err := VIN("VIN", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("VIN(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgInvalidVIN {
+			t.Errorf("VIN(%q) message key = %v", tt.value, err.MessageKey)
+		}
+	}
+}
+
+func TestNumericCode(t *testing.T) {
+	tests := []struct {
+		value   string
+		length  int
+		wantErr bool
+	}{
+		{"123456", 6, false},
+		{"000000", 6, false},
+		{"0042", 4, false},
+		{"12345", 6, true},
+		{"1234567", 6, true},
+		{"12a456", 6, true},
+		{"12 456", 6, true},
+		{"/23456", 6, true},
+		{":23456", 6, true},
+		{"", 6, true},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		err := NumericCode("Code", tt.value, tt.length)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NumericCode(%q, %d) error = %v, wantErr %v", tt.value, tt.length, err, tt.wantErr)
+		}
+		if err != nil && (err.MessageKey != MsgInvalidNumericCode || err.MessageParams[Length] != tt.length) {
+			t.Errorf("NumericCode(%q, %d) = %v %v", tt.value, tt.length, err.MessageKey, err.MessageParams)
+		}
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	// mod7 accepts numbers whose digits sum to a multiple of 7.
+	mod7 := func(s string) bool {
+		sum := 0
+		for _, c := range s {
+			sum += int(c - '0')
+		}
+		return s != "" && sum%7 == 0
+	}
+
+	tests := []struct {
+		value   string
+		key     string
+		wantKey string
+	}{
+		{"1600", "", ""},
+		{"1601", "", MsgInvalidChecksum},
+		{"1601", "validation.loyalty_card", "validation.loyalty_card"},
+		{"", "", MsgInvalidChecksum},
+	}
+
+	for _, tt := range tests {
+		err := Checksum("CardNumber", tt.value, mod7, tt.key)
+		if tt.wantKey == "" {
+			if err != nil {
+				t.Errorf("Checksum(%q) = %v, want nil", tt.value, err)
+			}
+			continue
+		}
+		if err == nil || err.MessageKey != tt.wantKey || err.Field != "CardNumber" || err.CurrentValue != tt.value {
+			t.Errorf("Checksum(%q) = %+v, want %s", tt.value, err, tt.wantKey)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package rapidval
+
+import "math"
+
+// HexColor validates a CSS hex color in the #rgb or #rrggbb form.
+func HexColor(field string, value string) *ValidationError {
+	if _, ok := parseHexColor(value); !ok {
+		return newError(field, MsgInvalidColor, value)
+	}
+	return nil
+}
+
+// ContrastRatioAtLeast validates that the WCAG 2 contrast ratio between the hex colors fg and bg is at least
+// ratio, e.g. 4.5 for normal text at level AA. Low contrast is reported on fieldFg; colors that are not
+// valid hex colors are reported as MsgInvalidColor on their own field.
+func ContrastRatioAtLeast(fieldFg, fg, fieldBg, bg string, ratio float64) *ValidationError {
+	fgRGB, ok := parseHexColor(fg)
+	if !ok {
+		return newError(fieldFg, MsgInvalidColor, fg)
+	}
+	bgRGB, ok := parseHexColor(bg)
+	if !ok {
+		return newError(fieldBg, MsgInvalidColor, bg)
+	}
+	l1, l2 := luminance(fgRGB), luminance(bgRGB)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	if (l1+0.05)/(l2+0.05) < ratio {
+		err := newError(fieldFg, MsgContrastRatio, fg)
+		err.MessageParams[Min] = ratio
+		return err
+	}
+	return nil
+}
+
+// parseHexColor parses #rgb and #rrggbb colors into their red, green and blue components.
+func parseHexColor(s string) ([3]uint8, bool) {
+	var rgb [3]uint8
+	if len(s) == 0 || s[0] != '#' {
+		return rgb, false
+	}
+	s = s[1:]
+	switch len(s) {
+	case 3:
+		for i := 0; i < 3; i++ {
+			d, ok := hexDigit(s[i])
+			if !ok {
+				return rgb, false
+			}
+			rgb[i] = d<<4 | d
+		}
+	case 6:
+		for i := 0; i < 3; i++ {
+			hi, ok1 := hexDigit(s[2*i])
+			lo, ok2 := hexDigit(s[2*i+1])
+			if !ok1 || !ok2 {
+				return rgb, false
+			}
+			rgb[i] = hi<<4 | lo
+		}
+	default:
+		return rgb, false
+	}
+	return rgb, true
+}
+
+func hexDigit(c byte) (uint8, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// luminance returns the WCAG 2 relative luminance of an sRGB color.
+func luminance(rgb [3]uint8) float64 {
+	var c [3]float64
+	for i, v := range rgb {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			c[i] = s / 12.92
+		} else {
+			c[i] = math.Pow((s+0.055)/1.055, 2.4)
+		}
+	}
+	return 0.2126*c[0] + 0.7152*c[1] + 0.0722*c[2]
+}
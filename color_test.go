@@ -0,0 +1,57 @@
+package rapidval
+
+import "testing"
+
+func TestHexColor(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"#fff", false},
+		{"#1A2b3C", false},
+		{"fff", true},
+		{"#ffff", true},
+		{"#ggg", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		err := HexColor("Color", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("HexColor(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+func TestContrastRatioAtLeast(t *testing.T) {
+	tests := []struct {
+		name      string
+		fg, bg    string
+		ratio     float64
+		wantField string
+		wantKey   string
+	}{
+		{"black on white", "#000", "#fff", 21, "", ""},
+		{"white on black", "#ffffff", "#000000", 7, "", ""},
+		{"gray passes aa", "#767676", "#fff", 4.5, "", ""},
+		{"gray fails aa", "#777777", "#fff", 4.5, "Foreground", MsgContrastRatio},
+		{"same color", "#336699", "#336699", 1.5, "Foreground", MsgContrastRatio},
+		{"invalid fg", "red", "#fff", 4.5, "Foreground", MsgInvalidColor},
+		{"invalid bg", "#000", "#12", 4.5, "Background", MsgInvalidColor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ContrastRatioAtLeast("Foreground", tt.fg, "Background", tt.bg, tt.ratio)
+			if tt.wantKey == "" {
+				if err != nil {
+					t.Errorf("ContrastRatioAtLeast() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Field != tt.wantField || err.MessageKey != tt.wantKey {
+				t.Errorf("ContrastRatioAtLeast() = %+v, want %s on %s", err, tt.wantKey, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package rapidval
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+type correlationKey struct{}
+
+// CorrelationIDFunc, if set, resolves the correlation ID of contexts without one stored by
+// WithCorrelationID, e.g. the request ID set by an existing middleware:
+//
+//	rapidval.CorrelationIDFunc = middleware.GetReqID
+var CorrelationIDFunc func(ctx context.Context) string
+
+// WithCorrelationID returns a copy of ctx that carries id, e.g. the ID of the current request.
+// Error writers and LogAttrs attach it to serialized validation errors, so a user reporting
+// a confusing message can be matched to the request that produced it.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationKey{}, id)
+}
+
+// CorrelationID returns the ID stored in ctx by WithCorrelationID, falling back to CorrelationIDFunc.
+// It returns "" if there is none.
+func CorrelationID(ctx context.Context) string {
+	if id, ok := ctx.Value(correlationKey{}).(string); ok {
+		return id
+	}
+	if CorrelationIDFunc != nil {
+		return CorrelationIDFunc(ctx)
+	}
+	return ""
+}
+
+// LogAttrs returns attributes describing err for log/slog: the correlation ID of ctx, if any,
+// and the field and message key of every validation error, e.g. "Email: validation.invalid_email". Values are not logged, so
+// sensitive input does not end up in logs:
+//
+//	logger.LogAttrs(ctx, slog.LevelInfo, "invalid request", rapidval.LogAttrs(ctx, err)...)
+func LogAttrs(ctx context.Context, err error) []slog.Attr {
+	var attrs []slog.Attr
+	if id := CorrelationID(ctx); id != "" {
+		attrs = append(attrs, slog.String("correlation_id", id))
+	}
+	var verrs ValidationErrors
+	if err == nil {
+		return attrs
+	}
+	if !errors.As(err, &verrs) {
+		return append(attrs, slog.String("error", err.Error()))
+	}
+	errs := make([]string, len(verrs))
+	for i, ve := range verrs {
+		errs[i] = ve.MessageKey
+		if ve.Field != "" {
+			errs[i] = ve.Field + ": " + ve.MessageKey
+		}
+	}
+	return append(attrs, slog.Any("validation_errors", errs))
+}
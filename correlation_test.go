@@ -0,0 +1,73 @@
+package rapidval
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+type reqIDKey struct{}
+
+func TestCorrelationID(t *testing.T) {
+	ctx := context.Background()
+	if id := CorrelationID(ctx); id != "" {
+		t.Errorf("CorrelationID() = %q, want empty", id)
+	}
+	if id := CorrelationID(WithCorrelationID(ctx, "req-1")); id != "req-1" {
+		t.Errorf("CorrelationID() = %q, want req-1", id)
+	}
+
+	CorrelationIDFunc = func(ctx context.Context) string {
+		id, _ := ctx.Value(reqIDKey{}).(string)
+		return id
+	}
+	defer func() { CorrelationIDFunc = nil }()
+
+	ctx = context.WithValue(ctx, reqIDKey{}, "mw-7")
+	if id := CorrelationID(ctx); id != "mw-7" {
+		t.Errorf("CorrelationID() = %q, want mw-7", id)
+	}
+	if id := CorrelationID(WithCorrelationID(ctx, "req-1")); id != "req-1" {
+		t.Errorf("CorrelationID() = %q, want WithCorrelationID to take precedence", id)
+	}
+}
+
+func TestLogAttrs(t *testing.T) {
+	ctx := WithCorrelationID(context.Background(), "req-1")
+	errs := ValidationErrors{
+		Required("Name", ""),
+		{MessageKey: MsgInternal, MessageParams: map[string]interface{}{Value: "secret"}},
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want string
+	}{
+		{"validation errors", ctx, errs, `level=INFO msg=invalid correlation_id=req-1 validation_errors="[Name: validation.required validation.internal]"`},
+		{"no correlation id", context.Background(), errs[:1], `level=INFO msg=invalid validation_errors="[Name: validation.required]"`},
+		{"other error", ctx, errors.New("boom"), `level=INFO msg=invalid correlation_id=req-1 error=boom`},
+		{"nil", ctx, nil, `level=INFO msg=invalid correlation_id=req-1`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+			logger.LogAttrs(tt.ctx, slog.LevelInfo, "invalid", LogAttrs(tt.ctx, tt.err)...)
+			if got := strings.TrimSpace(buf.String()); got != tt.want {
+				t.Errorf("log = %s\nwant  %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package rapidval
+
+import (
+	"cmp"
+	"time"
+)
+
+// EqualField validates that value equals the value of otherField, e.g. a password confirmation:
+//
+//	rapidval.EqualField("ConfirmPassword", u.ConfirmPassword, "Password", u.Password)
+//
+// The name of the other field is available to messages as OtherField.
+func EqualField[T comparable](field string, value T, otherField string, other T) *ValidationError {
+	if value != other {
+		return fieldError(field, MsgEqualField, value, otherField)
+	}
+	return nil
+}
+
+// GreaterThanField validates that value is strictly greater than the value of otherField,
+// e.g. a maximum price that must exceed the minimum price.
+func GreaterThanField[T cmp.Ordered](field string, value T, otherField string, other T) *ValidationError {
+	if value <= other {
+		return fieldError(field, MsgGreaterThanField, value, otherField)
+	}
+	return nil
+}
+
+// DateAfterField validates that value is strictly after the time of otherField:
+//
+//	rapidval.DateAfterField("EndDate", b.EndDate, "StartDate", b.StartDate)
+//
+// is reported as "EndDate must be after StartDate" by a translator with the message
+// "{{.Field}} must be after {{.OtherField}}".
+func DateAfterField(field string, value time.Time, otherField string, other time.Time) *ValidationError {
+	if !value.After(other) {
+		return fieldError(field, MsgDateAfterField, value, otherField)
+	}
+	return nil
+}
+
+func fieldError(field, key string, value interface{}, otherField string) *ValidationError {
+	err := newError(field, key, value)
+	err.MessageParams[OtherField] = otherField
+	return err
+}
@@ -0,0 +1,74 @@
+package rapidval
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCrossField(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		err     *ValidationError
+		wantKey string
+	}{
+		{"equal", EqualField("ConfirmPassword", "s3cret", "Password", "s3cret"), ""},
+		{"not equal", EqualField("ConfirmPassword", "s3cret", "Password", "secret"), MsgEqualField},
+		{"greater", GreaterThanField("MaxPrice", 10.5, "MinPrice", 10.0), ""},
+		{"equal is not greater", GreaterThanField("MaxPrice", 10, "MinPrice", 10), MsgGreaterThanField},
+		{"less", GreaterThanField("MaxPrice", 9, "MinPrice", 10), MsgGreaterThanField},
+		{"after", DateAfterField("EndDate", start.Add(time.Hour), "StartDate", start), ""},
+		{"same time", DateAfterField("EndDate", start, "StartDate", start), MsgDateAfterField},
+		{"before", DateAfterField("EndDate", start.Add(-time.Hour), "StartDate", start), MsgDateAfterField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantKey == "" {
+				if tt.err != nil {
+					t.Fatalf("error = %v, want nil", tt.err)
+				}
+				return
+			}
+			if tt.err == nil || tt.err.MessageKey != tt.wantKey {
+				t.Fatalf("error = %v, want %s", tt.err, tt.wantKey)
+			}
+			if tt.err.MessageParams[OtherField] == nil {
+				t.Errorf("OtherField param missing")
+			}
+		})
+	}
+}
+
+type booking struct {
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+func (b *booking) Validations() P {
+	return P{DateAfterField("EndDate", b.EndDate, "StartDate", b.StartDate)}
+}
+
+type trip struct {
+	Booking *booking
+}
+
+func (t *trip) Validations() P {
+	return P{Nested("Booking", t.Booking)}
+}
+
+func TestCrossFieldMessage(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	err := New().Validate(&trip{Booking: &booking{StartDate: start, EndDate: start}})
+	verrs, ok := err.(ValidationErrors)
+	if !ok || len(verrs) != 1 {
+		t.Fatalf("Validate() = %v, want one error", err)
+	}
+
+	tr := NewTranslatorWithMessages(map[string]string{MsgDateAfterField: "{{.Field}} must be after {{.OtherField}}"})
+	if got, want := tr.Translate(verrs[0]), "Booking.EndDate must be after Booking.StartDate"; got != want {
+		t.Errorf("Translate() = %q, want %q", got, want)
+	}
+	if got, want := NewTranslator().Translate(verrs[0]), "Booking.EndDate Booking.StartDate tarihinden sonra olmalıdır"; got != want {
+		t.Errorf("Translate() = %q, want %q", got, want)
+	}
+}
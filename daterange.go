@@ -0,0 +1,34 @@
+package rapidval
+
+import "time"
+
+// DateRange is a half-open time interval [Start, End), e.g. a booking from check-in to check-out.
+type DateRange struct {
+	Start, End time.Time
+}
+
+// NoOverlap validates that no two date ranges overlap. Ranges are half-open, so a booking may start
+// when another one ends. Of two overlapping ranges, the one starting later is reported with its index,
+// e.g. "Bookings[2]", and the index of the other is available as the Token param.
+func NoOverlap(field string, ranges []DateRange) *ValidationError {
+	earlier, later, found := firstOverlap(len(ranges),
+		func(i int) time.Time { return ranges[i].Start },
+		func(i int) time.Time { return ranges[i].End },
+		time.Time.Compare)
+	if found {
+		return overlapError(field, earlier, later, ranges[later])
+	}
+	return nil
+}
+
+// RangeWithin validates that r starts no earlier than bounds.Start and ends no later than bounds.End,
+// e.g. that a reservation falls within the season. The bounds are available as the Min and Max params.
+func RangeWithin(field string, r, bounds DateRange) *ValidationError {
+	if r.Start.Before(bounds.Start) || r.End.After(bounds.End) {
+		err := newError(field, MsgRangeWithin, r)
+		err.MessageParams[Min] = bounds.Start
+		err.MessageParams[Max] = bounds.End
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package rapidval
+
+import (
+	"testing"
+	"time"
+)
+
+func day(d int) time.Time {
+	return time.Date(2025, time.July, d, 14, 0, 0, 0, time.UTC)
+}
+
+func TestNoOverlap(t *testing.T) {
+	tests := []struct {
+		name      string
+		ranges    []DateRange
+		wantField string
+	}{
+		{"empty", nil, ""},
+		{"back to back", []DateRange{{day(1), day(3)}, {day(3), day(5)}}, ""},
+		{"unsorted", []DateRange{{day(10), day(12)}, {day(1), day(3)}}, ""},
+		{"overlap", []DateRange{{day(1), day(4)}, {day(3), day(5)}}, "Bookings[1]"},
+		{"unsorted overlap", []DateRange{{day(3), day(5)}, {day(8), day(9)}, {day(1), day(4)}}, "Bookings[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NoOverlap("Bookings", tt.ranges)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("NoOverlap() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Field != tt.wantField || err.MessageKey != MsgOverlap {
+				t.Errorf("NoOverlap() = %+v, want an overlap on %s", err, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestRangeWithin(t *testing.T) {
+	season := DateRange{day(1), day(31)}
+
+	tests := []struct {
+		name    string
+		r       DateRange
+		wantErr bool
+	}{
+		{"inside", DateRange{day(5), day(10)}, false},
+		{"whole season", season, false},
+		{"starts early", DateRange{day(1).Add(-time.Hour), day(10)}, true},
+		{"ends late", DateRange{day(25), day(31).Add(time.Minute)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RangeWithin("Stay", tt.r, season)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RangeWithin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && (err.MessageKey != MsgRangeWithin || !err.MessageParams[Min].(time.Time).Equal(season.Start)) {
+				t.Errorf("RangeWithin() = %v %v", err.MessageKey, err.MessageParams)
+			}
+		})
+	}
+}
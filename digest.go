@@ -0,0 +1,140 @@
+package rapidval
+
+import "strings"
+
+// HexDigest validates a hex encoded digest of size bytes, e.g. HexDigest("Checksum", sum, sha256.Size)
+// for a SHA-256 digest of 64 hex characters. Upper and lower case hex digits are accepted.
+func HexDigest(field string, value string, size int) *ValidationError {
+	if len(value) != 2*size || !isHex(value) {
+		err := newError(field, MsgInvalidDigest, value)
+		err.MessageParams[Length] = 2 * size
+		return err
+	}
+	return nil
+}
+
+// ETag validates an entity tag as defined by RFC 9110: a double-quoted opaque string,
+// optionally prefixed with W/ for weak tags, e.g. "33a64df5" or W/"0815".
+func ETag(field string, value string) *ValidationError {
+	tag := value
+	if len(tag) > 2 && tag[0] == 'W' && tag[1] == '/' {
+		tag = tag[2:]
+	}
+	if !opaqueTag(tag) {
+		return newError(field, MsgInvalidETag, value)
+	}
+	return nil
+}
+
+// StrongETag is like ETag but rejects weak entity tags, for APIs that compare content byte for byte.
+func StrongETag(field string, value string) *ValidationError {
+	if !opaqueTag(value) {
+		return newError(field, MsgInvalidETag, value)
+	}
+	return nil
+}
+
+// opaqueTag reports whether s is a double-quoted string of etagc characters:
+// visible ASCII except the double quote, and bytes of 0x80 and above.
+func opaqueTag(s string) bool {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		if c < 0x21 || c == '"' || c == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if _, ok := hexDigit(s[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// PasswordHashFormat validates that value is a well-formed bcrypt ($2a$, $2b$, $2y$) or
+// Argon2 ($argon2id$, $argon2i$, $argon2d$) password hash in the modular crypt format.
+// It checks the structure only; it cannot tell whether the hash was computed correctly.
+func PasswordHashFormat(field string, value string) *ValidationError {
+	if !bcryptHash(value) && !argon2Hash(value) {
+		return newError(field, MsgInvalidPasswordHash, value)
+	}
+	return nil
+}
+
+// bcryptHash reports whether s looks like $2b$10$ followed by 53 characters of bcrypt's base64
+// alphabet (22 for the salt, 31 for the hash), with a cost between 4 and 31.
+func bcryptHash(s string) bool {
+	if len(s) != 60 || s[0] != '$' || s[1] != '2' || s[3] != '$' || s[6] != '$' {
+		return false
+	}
+	if s[2] != 'a' && s[2] != 'b' && s[2] != 'y' {
+		return false
+	}
+	if !digits(s[4:6]) {
+		return false
+	}
+	if cost := int(s[4]-'0')*10 + int(s[5]-'0'); cost < 4 || cost > 31 {
+		return false
+	}
+	for i := 7; i < len(s); i++ {
+		if !base64Char(s[i]) || s[i] == '+' {
+			return false
+		}
+	}
+	return true
+}
+
+// argon2Hash reports whether s looks like $argon2id$v=19$m=65536,t=3,p=4$salt$hash,
+// with the salt and hash in unpadded standard base64.
+func argon2Hash(s string) bool {
+	parts := strings.Split(s, "$")
+	if len(parts) != 6 || parts[0] != "" {
+		return false
+	}
+	switch parts[1] {
+	case "argon2id", "argon2i", "argon2d":
+	default:
+		return false
+	}
+	if !strings.HasPrefix(parts[2], "v=") || !positive(parts[2][2:]) {
+		return false
+	}
+	params := strings.Split(parts[3], ",")
+	if len(params) != 3 {
+		return false
+	}
+	for i, name := range []string{"m=", "t=", "p="} {
+		if !strings.HasPrefix(params[i], name) || !positive(params[i][2:]) {
+			return false
+		}
+	}
+	for _, b64 := range parts[4:] {
+		if b64 == "" {
+			return false
+		}
+		for i := 0; i < len(b64); i++ {
+			if !base64Char(b64[i]) || b64[i] == '.' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// positive reports whether s is a decimal number greater than zero.
+func positive(s string) bool {
+	return s != "" && digits(s) && strings.Trim(s, "0") != ""
+}
+
+// base64Char reports whether c belongs to the standard base64 alphabet or is the '.' used by bcrypt
+// in place of '+'.
+func base64Char(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '+' || c == '/' || c == '.'
+}
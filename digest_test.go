@@ -0,0 +1,100 @@
+package rapidval
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"testing"
+)
+
+func TestHexDigest(t *testing.T) {
+	tests := []struct {
+		value   string
+		size    int
+		wantErr bool
+	}{
+		{"d41d8cd98f00b204e9800998ecf8427e", md5.Size, false},
+		{"D41D8CD98F00B204E9800998ECF8427E", md5.Size, false},
+		{"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", sha256.Size, false},
+		{"d41d8cd98f00b204e9800998ecf8427e", sha256.Size, true},
+		{"d41d8cd98f00b204e9800998ecf8427g", md5.Size, true},
+		{"", md5.Size, true},
+	}
+
+	for _, tt := range tests {
+		err := HexDigest("Checksum", tt.value, tt.size)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("HexDigest(%q, %d) error = %v, wantErr %v", tt.value, tt.size, err, tt.wantErr)
+		}
+		if err != nil && err.MessageParams[Length] != 2*tt.size {
+			t.Errorf("HexDigest(%q, %d) Length = %v", tt.value, tt.size, err.MessageParams[Length])
+		}
+	}
+}
+
+func TestETag(t *testing.T) {
+	tests := []struct {
+		value      string
+		wantErr    bool
+		wantStrong bool
+	}{
+		{`"33a64df5"`, false, true},
+		{`""`, false, true},
+		{`W/"0815"`, false, false},
+		{`33a64df5`, true, false},
+		{`"33a6"4df5"`, true, false},
+		{`"33a6 4df5"`, true, false},
+		{`w/"0815"`, true, false},
+		{`W/`, true, false},
+		{`W/"0815`, true, false},
+		{``, true, false},
+	}
+
+	for _, tt := range tests {
+		err := ETag("ETag", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ETag(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && err.CurrentValue != tt.value {
+			t.Errorf("ETag(%q) CurrentValue = %v, want the original value", tt.value, err.CurrentValue)
+		}
+		if err := StrongETag("ETag", tt.value); (err == nil) != tt.wantStrong {
+			t.Errorf("StrongETag(%q) error = %v, want valid %v", tt.value, err, tt.wantStrong)
+		}
+	}
+}
+
+func TestPasswordHashFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"bcrypt 2a", "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy", false},
+		{"bcrypt 2b", "$2b$12$KIXQJZkCHmjCxYpR9dPbKeH3Q8SEXHVYrO8Ha5PE6dNxRp0bLQjUa", false},
+		{"bcrypt cost too low", "$2a$03$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy", true},
+		{"bcrypt unknown version", "$2x$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy", true},
+		{"bcrypt truncated", "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhW", true},
+		{"bcrypt plus", "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lh+y", true},
+		{"argon2id", "$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$RdescudvJCsgt3ub+b+dWRWJTmaaJObG", false},
+		{"argon2i", "$argon2i$v=19$m=4096,t=3,p=1$c29tZXNhbHQ$iWh06vD8Fy27wf9npn6FXWiCX4K6pW6Ue1Bnzz07Z8A", false},
+		{"argon2 missing version", "$argon2id$m=65536,t=3,p=4$c29tZXNhbHQ$RdescudvJCsgt3ub+b+dWRWJTmaaJObG", true},
+		{"argon2 zero memory", "$argon2id$v=19$m=0,t=3,p=4$c29tZXNhbHQ$RdescudvJCsgt3ub+b+dWRWJTmaaJObG", true},
+		{"argon2 params order", "$argon2id$v=19$t=3,m=65536,p=4$c29tZXNhbHQ$RdescudvJCsgt3ub+b+dWRWJTmaaJObG", true},
+		{"argon2 empty hash", "$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ$", true},
+		{"argon2 padded", "$argon2id$v=19$m=65536,t=3,p=4$c29tZXNhbHQ=$RdescudvJCsgt3ub+b+dWRWJTmaaJObG", true},
+		{"plain text", "hunter2", true},
+		{"sha256", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := PasswordHashFormat("PasswordHash", tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PasswordHashFormat(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if err != nil && err.MessageKey != MsgInvalidPasswordHash {
+				t.Errorf("PasswordHashFormat(%q) message key = %v", tt.value, err.MessageKey)
+			}
+		})
+	}
+}
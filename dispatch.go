@@ -0,0 +1,84 @@
+package rapidval
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+var typeRules = struct {
+	sync.RWMutex
+	rules map[reflect.Type]func(value interface{}) P
+}{rules: map[reflect.Type]func(value interface{}) P{}}
+
+// RegisterRules registers the rules Dispatch applies to values of type T that do not implement Validateable,
+// e.g. implementations of an interface that are declared in another package.
+// Registering the same type twice replaces the previous rules.
+func RegisterRules[T any](rules func(T) P) {
+	typeRules.Lock()
+	defer typeRules.Unlock()
+	typeRules.rules[reflect.TypeOf((*T)(nil)).Elem()] = func(value interface{}) P {
+		return rules(value.(T))
+	}
+}
+
+func lookupRules(t reflect.Type) (func(value interface{}) P, bool) {
+	typeRules.RLock()
+	defer typeRules.RUnlock()
+	rules, ok := typeRules.rules[t]
+	return rules, ok
+}
+
+// Dispatch validates a field declared as an interface by its concrete value: if the value implements
+// Validateable its Validations are evaluated, otherwise the rules registered for its type with
+// RegisterRules. Errors are reported under the field, e.g. "Payment.Number".
+//
+// Nil values and nil pointers pass; combine Dispatch with Required to reject them.
+// Values of types that are neither Validateable nor registered pass as well.
+func Dispatch(field string, value interface{}) Rule {
+	return dispatchRule{field: field, value: value}
+}
+
+// Nested validates a child struct with its own Validations and reports its errors under the field,
+// e.g. "Address.City". Nested structs can be nested again, giving paths such as "Address.Geo.Lat".
+// A nil pointer passes; combine Nested with Required to reject it.
+func Nested(field string, value Validateable) Rule {
+	return dispatchRule{field: field, value: value}
+}
+
+type dispatchRule struct {
+	field string
+	value interface{}
+}
+
+// Check implements Rule for callers evaluating the rule on its own. It returns the first error only.
+func (d dispatchRule) Check(ctx context.Context) *ValidationError {
+	if errs := d.collect(ctx, &Validator{}, "", fieldPrefix{}, nil); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (d dispatchRule) collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	errs, _ = v.dispatch(ctx, prefix.nest(d.field, -1), d.value, errs)
+	return errs
+}
+
+// dispatch appends the errors of value, whose fields are prefixed by prefix.
+// It reports whether value was validated by its Validations method or registered rules.
+func (v *Validator) dispatch(ctx context.Context, prefix fieldPrefix, value interface{}, errs ValidationErrors) (ValidationErrors, bool) {
+	if value == nil {
+		return errs, false
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return errs, false
+	}
+	if val, ok := value.(Validateable); ok {
+		return v.collect(ctx, source{value: val, suffix: ".Validations"}, validationsFunc(ctx, val), prefix, errs), true
+	}
+	if rules, ok := lookupRules(rv.Type()); ok {
+		return v.collect(ctx, source{value: value, suffix: ".rules"}, func() P { return rules(value) }, prefix, errs), true
+	}
+	return errs, false
+}
@@ -0,0 +1,167 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+)
+
+type paymentMethod interface {
+	Kind() string
+}
+
+type cardPayment struct {
+	Number string
+}
+
+func (c *cardPayment) Kind() string { return "card" }
+
+func (c *cardPayment) Validations() P {
+	return P{
+		Required("Number", c.Number),
+		MinLength("Number", c.Number, 12),
+	}
+}
+
+// bankPayment does not implement Validateable; its rules are registered instead.
+type bankPayment struct {
+	IBAN string
+}
+
+func (b bankPayment) Kind() string { return "bank" }
+
+type cashPayment struct{}
+
+func (cashPayment) Kind() string { return "cash" }
+
+func init() {
+	RegisterRules(func(b bankPayment) P {
+		return P{Required("IBAN", b.IBAN)}
+	})
+}
+
+type order struct {
+	ID      string
+	Payment paymentMethod
+}
+
+func (o *order) Validations() P {
+	return P{
+		Required("ID", o.ID),
+		Dispatch("Payment", o.Payment),
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		payment paymentMethod
+		want    map[string]string
+	}{
+		{"validateable", &cardPayment{Number: "123"}, map[string]string{"Payment.Number": MsgMinLength}},
+		{"registered", bankPayment{}, map[string]string{"Payment.IBAN": MsgRequired}},
+		{"valid", bankPayment{IBAN: "TR00"}, nil},
+		{"unregistered", cashPayment{}, nil},
+		{"nil", nil, nil},
+		{"nil pointer", (*cardPayment)(nil), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New().Validate(&order{ID: "1", Payment: tt.payment})
+			verr, _ := err.(ValidationErrors)
+			if len(verr) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want %v", err, tt.want)
+			}
+			for _, e := range verr {
+				if tt.want[e.Field] != e.MessageKey || e.MessageParams[Field] != e.Field {
+					t.Errorf("field %s: message key = %v, want %v", e.Field, e.MessageKey, tt.want[e.Field])
+				}
+			}
+		})
+	}
+}
+
+func TestDispatchCheck(t *testing.T) {
+	err := Dispatch("Payment", &cardPayment{}).Check(context.Background())
+	if err == nil || err.Field != "Payment.Number" || err.MessageKey != MsgRequired {
+		t.Errorf("Check() = %v, want the first nested error", err)
+	}
+}
+
+func TestDispatchStats(t *testing.T) {
+	v := New(WithStats())
+	_ = v.Validate(&order{ID: "1", Payment: bankPayment{}})
+
+	stats := v.Stats()
+	for _, name := range []string{"rapidval.order.Validations", "rapidval.bankPayment.rules"} {
+		if s := stats[name]; s.Calls != 1 || s.Failures != 1 {
+			t.Errorf("stats[%q] = %+v, want 1 failed call", name, s)
+		}
+	}
+}
+
+type nestedGeo struct {
+	Lat, Lng float64
+}
+
+func (g *nestedGeo) Validations() P {
+	return P{WithinBoundingBox("Lat", g.Lat, g.Lng, BoundingBox{MinLat: -90, MinLng: -180, MaxLat: 90, MaxLng: 180})}
+}
+
+type nestedAddress struct {
+	City string
+	Geo  *nestedGeo
+}
+
+func (a *nestedAddress) Validations() P {
+	return P{
+		Required("City", a.City),
+		Nested("Geo", a.Geo),
+	}
+}
+
+type nestedUser struct {
+	Name    string
+	Address *nestedAddress
+}
+
+func (u *nestedUser) Validations() P {
+	return P{
+		Required("Name", u.Name),
+		Required("Address", u.Address),
+		Nested("Address", u.Address),
+	}
+}
+
+func TestNested(t *testing.T) {
+	tests := []struct {
+		name string
+		user nestedUser
+		want map[string]string
+	}{
+		{"valid", nestedUser{Name: "John", Address: &nestedAddress{City: "Istanbul"}}, nil},
+		{"nil child", nestedUser{Name: "John"}, map[string]string{"Address": MsgRequired}},
+		{"child errors", nestedUser{Address: &nestedAddress{}}, map[string]string{
+			"Name":         MsgRequired,
+			"Address.City": MsgRequired,
+		}},
+		{"grandchild errors", nestedUser{Name: "John", Address: &nestedAddress{City: "Istanbul", Geo: &nestedGeo{Lat: 91}}}, map[string]string{
+			"Address.Geo.Lat": MsgOutsideArea,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New().Validate(&tt.user)
+			verr, _ := err.(ValidationErrors)
+			if len(verr) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want %v", err, tt.want)
+			}
+			for _, e := range verr {
+				if tt.want[e.Field] != e.MessageKey || e.MessageParams[Field] != e.Field {
+					t.Errorf("field %s: message key = %v, want %v", e.Field, e.MessageKey, tt.want[e.Field])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package rapidval
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+)
+
+// Each applies rules to every element of items and reports the errors under the indexed field,
+// e.g. "Items[2].Name" for Required("Name", item.Name). Rules without a field are reported on the
+// element itself, e.g. "Emails[1]" for Email("", email):
+//
+//	rapidval.Each("Items", o.Items, func(item Item) rapidval.P {
+//	    return rapidval.P{
+//	        rapidval.Required("Name", item.Name),
+//	        rapidval.Between("Quantity", item.Quantity, 1, 100),
+//	    }
+//	})
+//
+// The rules are reported in stats and traces under the name of the function.
+func Each[T any](field string, items []T, rules func(item T) P) Rule {
+	return eachRule{
+		field: field,
+		n:     len(items),
+		fn:    rules,
+		rules: func(i int) P { return rules(items[i]) },
+	}
+}
+
+// EachNested validates every element of items with its own Validations and reports the errors
+// under the indexed field, e.g. "Rows[2].Email". Nil elements pass.
+func EachNested[T Validateable](field string, items []T) Rule {
+	return eachRule{
+		field: field,
+		n:     len(items),
+		item:  func(i int) interface{} { return items[i] },
+	}
+}
+
+type eachRule struct {
+	field string
+	n     int
+
+	// fn and rules are set by Each, item by EachNested.
+	fn    interface{}
+	rules func(i int) P
+	item  func(i int) interface{}
+}
+
+// Check implements Rule for callers evaluating the rule on its own. It returns the first error only.
+func (e eachRule) Check(ctx context.Context) *ValidationError {
+	if errs := e.collect(ctx, &Validator{}, "", fieldPrefix{}, nil); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (e eachRule) collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	if e.n == 0 {
+		return errs
+	}
+	if e.rules != nil {
+		name = funcName(e.fn)
+	}
+	for i := 0; i < e.n; i++ {
+		p := prefix.nest(e.field, i)
+		if e.item != nil {
+			errs, _ = v.dispatch(ctx, p, e.item(i), errs)
+			continue
+		}
+		i := i
+		errs = v.collect(ctx, source{name: name}, func() P { return e.rules(i) }, p, errs)
+	}
+	return errs
+}
+
+// funcName returns the name of the function fn, e.g. "examples.(*Order).Validations.func1".
+func funcName(fn interface{}) string {
+	if f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()); f != nil {
+		return f.Name()
+	}
+	return "func"
+}
@@ -0,0 +1,95 @@
+package rapidval
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type eachItem struct {
+	Name     string
+	Quantity int
+}
+
+type eachOrder struct {
+	Items  []eachItem
+	Emails []string
+	Rows   []*nestedAddress
+}
+
+func (o *eachOrder) Validations() P {
+	return P{
+		Each("Items", o.Items, func(item eachItem) P {
+			return P{
+				Required("Name", item.Name),
+				Between("Quantity", item.Quantity, 1, 100),
+			}
+		}),
+		Each("Emails", o.Emails, func(email string) P {
+			return P{Email("", email)}
+		}),
+		EachNested("Rows", o.Rows),
+	}
+}
+
+func TestEach(t *testing.T) {
+	tests := []struct {
+		name  string
+		order eachOrder
+		want  map[string]string
+	}{
+		{"empty", eachOrder{}, nil},
+		{"valid", eachOrder{
+			Items:  []eachItem{{Name: "Pen", Quantity: 2}},
+			Emails: []string{"a@example.com"},
+			Rows:   []*nestedAddress{{City: "Istanbul"}, nil},
+		}, nil},
+		{"indexed errors", eachOrder{
+			Items:  []eachItem{{Name: "Pen", Quantity: 2}, {Name: "Ink", Quantity: 0}, {Quantity: 1}},
+			Emails: []string{"a@example.com", "invalid"},
+			Rows:   []*nestedAddress{{City: "Istanbul"}, {}},
+		}, map[string]string{
+			"Items[1].Quantity": MsgBetween,
+			"Items[2].Name":     MsgRequired,
+			"Emails[1]":         MsgInvalidEmail,
+			"Rows[1].City":      MsgRequired,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New().Validate(&tt.order)
+			verr, _ := err.(ValidationErrors)
+			if len(verr) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want %v", err, tt.want)
+			}
+			for _, e := range verr {
+				if tt.want[e.Field] != e.MessageKey || e.MessageParams[Field] != e.Field {
+					t.Errorf("field %s: message key = %v, want %v", e.Field, e.MessageKey, tt.want[e.Field])
+				}
+			}
+		})
+	}
+}
+
+func TestEachCheck(t *testing.T) {
+	err := Each("Tags", []string{"a", ""}, func(tag string) P {
+		return P{Required("", tag)}
+	}).Check(context.Background())
+	if err == nil || err.Field != "Tags[1]" {
+		t.Errorf("Check() = %v, want an error on Tags[1]", err)
+	}
+}
+
+func TestEachPanic(t *testing.T) {
+	rule := Each("Items", []eachItem{{}}, func(item eachItem) P {
+		panic("boom")
+	})
+	err := rule.Check(context.Background())
+	if err == nil || err.MessageKey != MsgInternal || err.Field != "Items[0]" {
+		t.Fatalf("Check() = %+v, want an internal error on Items[0]", err)
+	}
+	if name, _ := err.MessageParams[RuleName].(string); !strings.Contains(name, "TestEachPanic") {
+		t.Errorf("Rule param = %q, want the name of the rules function", name)
+	}
+}
@@ -0,0 +1,136 @@
+package rapidval
+
+import "strings"
+
+// Email validates if a string is a valid email address as defined by the addr-spec of RFC 5322:
+// a dot-atom or quoted local part, "@", and a dot-atom domain or a domain literal such as "[192.0.2.1]".
+// Display names ("John <john@example.com>"), comments and obsolete forms are not accepted. Non-ASCII
+// characters are allowed as in RFC 6531. Domains without a dot, such as "localhost", are valid;
+// use EmailStrict to require a public domain name.
+func Email(field string, value string) *ValidationError {
+	if _, ok := parseEmail(value); !ok {
+		return newError(field, MsgInvalidEmail, value)
+	}
+	return nil
+}
+
+// EmailStrict is like Email but also requires the domain to have the shape of a public host name:
+// at least two labels of letters, digits and hyphens, each at most 63 characters and not starting or
+// ending with a hyphen, and a top-level domain of at least two letters or an IDN ("xn--") label.
+func EmailStrict(field string, value string) *ValidationError {
+	domain, ok := parseEmail(value)
+	if !ok || !hostName(domain) {
+		return newError(field, MsgInvalidEmail, value)
+	}
+	return nil
+}
+
+// parseEmail checks the syntax of an addr-spec and returns its domain. The local part is limited to
+// 64 bytes and the address to 254 bytes, the longest address that fits in an SMTP path.
+func parseEmail(s string) (domain string, ok bool) {
+	if len(s) > 254 {
+		return "", false
+	}
+	at := strings.LastIndexByte(s, '@')
+	if at <= 0 || at > 64 {
+		return "", false
+	}
+	local, domain := s[:at], s[at+1:]
+	if local[0] == '"' {
+		if !quotedString(local) {
+			return "", false
+		}
+	} else if !dotAtom(local) {
+		return "", false
+	}
+	if strings.HasPrefix(domain, "[") {
+		return domain, domainLiteral(domain)
+	}
+	return domain, dotAtom(domain)
+}
+
+// dotAtom reports whether s is one or more runs of atext separated by single dots.
+func dotAtom(s string) bool {
+	if s == "" || s[0] == '.' || s[len(s)-1] == '.' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' {
+			if s[i-1] == '.' {
+				return false
+			}
+			continue
+		}
+		if !atext(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// atext reports whether c may appear in an atom: letters, digits, the symbols listed in RFC 5322
+// and, for internationalized addresses, UTF-8 bytes.
+func atext(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c >= 0x80:
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) >= 0
+}
+
+// quotedString reports whether s is a double-quoted string of printable characters and spaces,
+// in which '"' and '\' are escaped with a backslash.
+func quotedString(s string) bool {
+	if len(s) < 2 || s[len(s)-1] != '"' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		c := s[i]
+		switch {
+		case c == '\\':
+			i++
+			if i == len(s)-1 || s[i] < ' ' && s[i] != '\t' || s[i] == 0x7f {
+				return false
+			}
+		case c == '"', c < ' ' && c != '\t', c == 0x7f:
+			return false
+		}
+	}
+	return true
+}
+
+// domainLiteral reports whether s is a bracketed domain literal such as "[192.0.2.1]" or "[IPv6:2001:db8::1]".
+func domainLiteral(s string) bool {
+	if len(s) < 3 || s[len(s)-1] != ']' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		if c := s[i]; c < '!' || c > '~' || c == '[' || c == ']' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
+
+// hostName reports whether s has the shape of a public host name, as required by EmailStrict.
+func hostName(s string) bool {
+	if len(s) > 253 {
+		return false
+	}
+	labels := 0
+	for rest := s; ; labels++ {
+		label, next, more := strings.Cut(rest, ".")
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			if c := label[i]; !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+		if !more {
+			return labels >= 1 && (len(label) >= 2 && isAlpha(label) || len(label) > 4 && strings.EqualFold(label[:4], "xn--"))
+		}
+		rest = next
+	}
+}
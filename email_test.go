@@ -0,0 +1,57 @@
+package rapidval
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmailRFC5322(t *testing.T) {
+	tests := []struct {
+		value      string
+		wantValid  bool
+		wantStrict bool
+	}{
+		{"user@example.com", true, true},
+		{"first.last+tag@sub.example.co.uk", true, true},
+		{"o'brien@example.org", true, true},
+		{"user@localhost", true, false},
+		{"user@[192.0.2.1]", true, false},
+		{"user@[IPv6:2001:db8::1]", true, false},
+		{`"john doe"@example.com`, true, true},
+		{`"a\"b"@example.com`, true, true},
+		{"üser@example.com", true, true},
+		{"user@xn--bcher-kva.example", true, true},
+		{"user@example.xn--p1ai", true, true},
+		{"a@b.", false, false},
+		{"a@.b", false, false},
+		{"a@b..c", false, false},
+		{".a@b.com", false, false},
+		{"a.@b.com", false, false},
+		{"a..b@c.com", false, false},
+		{"test.com", false, false},
+		{"test@", false, false},
+		{"@example.com", false, false},
+		{"a b@example.com", false, false},
+		{`"unterminated@example.com`, false, false},
+		{"John <john@example.com>", false, false},
+		{"user@[192.0.2.1", false, false},
+		{"user@example.c", true, false},
+		{"user@example.123", true, false},
+		{"user@-example.com", true, false},
+		{"user@exa_mple.com", true, false},
+		{strings.Repeat("a", 65) + "@example.com", false, false},
+		{"a@" + strings.Repeat("b", 250) + ".com", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if err := Email("email", tt.value); (err == nil) != tt.wantValid {
+				t.Errorf("Email(%q) error = %v, want valid %v", tt.value, err, tt.wantValid)
+			} else if err != nil && err.MessageKey != MsgInvalidEmail {
+				t.Errorf("Email(%q) key = %q", tt.value, err.MessageKey)
+			}
+			if err := EmailStrict("email", tt.value); (err == nil) != tt.wantStrict {
+				t.Errorf("EmailStrict(%q) error = %v, want valid %v", tt.value, err, tt.wantStrict)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+//go:build js && wasm
+
+// Command wasm exposes the signup schema to JavaScript as rapidvalValidate(schemaID, json).
+//
+//	GOOS=js GOARCH=wasm go build -tags rapidval_noreflect -o rapidval.wasm ./examples/wasm
+package main
+
+import (
+	"github.com/9ssi7/rapidval"
+	"github.com/9ssi7/rapidval/rapidvalwasm"
+	"github.com/9ssi7/rapidval/schema"
+)
+
+func main() {
+	schema.Register("signup", schema.Object(map[string]schema.Node{
+		"name":  schema.String().Required().Min(2),
+		"email": schema.String().Required().Email(),
+		"age":   schema.Number().Integer().Min(18),
+	}))
+	rapidvalwasm.Export("rapidvalValidate", rapidval.NewTranslator())
+	select {}
+}
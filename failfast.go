@@ -0,0 +1,16 @@
+package rapidval
+
+// WithFailFast makes the Validator stop at the first error, for hot paths where only pass or fail
+// matters. Lazy rules, nested values and the items of Each after the first error are not evaluated,
+// and at most one error is returned. Eager rules have already run while P was built, but their
+// errors are not collected. Warnings do not stop validation.
+func WithFailFast() Option {
+	return func(v *Validator) {
+		v.failFast = true
+	}
+}
+
+// stopped reports whether a fail-fast Validator has already collected an error.
+func (v *Validator) stopped(errs ValidationErrors) bool {
+	return v.failFast && hasError(errs)
+}
@@ -0,0 +1,65 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+)
+
+type failFastOrder struct {
+	Name  string
+	Items []string
+	lazy  *int
+}
+
+func (o *failFastOrder) Validations() P {
+	return P{
+		Warn(MinLength("Name", o.Name, 10)),
+		Required("Name", o.Name),
+		RuleFunc(func(ctx context.Context) *ValidationError {
+			*o.lazy++
+			return nil
+		}),
+		Each("Items", o.Items, func(item string) P {
+			*o.lazy++
+			return P{Required("", item)}
+		}),
+	}
+}
+
+func TestWithFailFast(t *testing.T) {
+	tests := []struct {
+		name         string
+		order        failFastOrder
+		opts         []Option
+		wantErrs     []string
+		wantWarnings int
+		wantLazy     int
+	}{
+		{"collects all", failFastOrder{Items: []string{"", ""}}, nil, []string{"Name", "Items[0]", "Items[1]"}, 1, 3},
+		{"stops at first error", failFastOrder{Items: []string{"", ""}}, []Option{WithFailFast()}, []string{"Name"}, 1, 0},
+		{"stops inside Each", failFastOrder{Name: "Ada", Items: []string{"a", "", ""}}, []Option{WithFailFast()}, []string{"Items[1]"}, 1, 3},
+		{"warnings do not stop", failFastOrder{Name: "Ada", Items: []string{"a"}}, []Option{WithFailFast()}, nil, 1, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lazy := 0
+			tt.order.lazy = &lazy
+			res := New(tt.opts...).Run(&tt.order)
+			errs := res.Errors()
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("Errors() = %v, want errors on %v", errs, tt.wantErrs)
+			}
+			for i, field := range tt.wantErrs {
+				if errs[i].Field != field {
+					t.Errorf("errs[%d].Field = %v, want %v", i, errs[i].Field, field)
+				}
+			}
+			if len(res.Warnings()) != tt.wantWarnings {
+				t.Errorf("Warnings() = %v, want %d", res.Warnings(), tt.wantWarnings)
+			}
+			if lazy != tt.wantLazy {
+				t.Errorf("lazy evaluations = %d, want %d", lazy, tt.wantLazy)
+			}
+		})
+	}
+}
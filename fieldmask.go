@@ -0,0 +1,46 @@
+package rapidval
+
+import "strings"
+
+// TreeSpec declares the paths permitted in a field mask. Each key is a field name and its value the
+// fields below it; a nil or empty TreeSpec marks a leaf. The key "*" matches any name, e.g. map keys:
+//
+//	rapidval.TreeSpec{
+//	    "name":    nil,
+//	    "address": {"city": nil, "zip": nil},
+//	    "labels":  {"*": nil},
+//	}
+type TreeSpec map[string]TreeSpec
+
+// FieldMask validates the dot-separated paths of an update mask or sparse fieldset, such as
+// "address.city", against allowed. A path may stop at any field, selecting everything below it, but may not
+// continue past a leaf. The first invalid path is reported as MsgInvalidFieldMask with the Token param.
+func FieldMask(field string, paths []string, allowed TreeSpec) *ValidationError {
+	for _, path := range paths {
+		if !allowed.permits(path) {
+			err := newError(field, MsgInvalidFieldMask, paths)
+			err.MessageParams[Token] = path
+			return err
+		}
+	}
+	return nil
+}
+
+func (t TreeSpec) permits(path string) bool {
+	for {
+		name, rest, more := strings.Cut(path, ".")
+		if name == "" {
+			return false
+		}
+		child, ok := t[name]
+		if !ok {
+			if child, ok = t["*"]; !ok {
+				return false
+			}
+		}
+		if !more {
+			return true
+		}
+		t, path = child, rest
+	}
+}
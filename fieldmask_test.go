@@ -0,0 +1,42 @@
+package rapidval
+
+import "testing"
+
+func TestFieldMask(t *testing.T) {
+	allowed := TreeSpec{
+		"name":    nil,
+		"address": {"city": nil, "geo": {"lat": nil, "lng": nil}},
+		"labels":  {"*": nil},
+	}
+
+	tests := []struct {
+		paths     []string
+		wantToken string
+		wantErr   bool
+	}{
+		{nil, "", false},
+		{[]string{"name"}, "", false},
+		{[]string{"name", "address.city"}, "", false},
+		{[]string{"address"}, "", false},
+		{[]string{"address.geo.lat"}, "", false},
+		{[]string{"labels.team"}, "", false},
+		{[]string{"name", "email"}, "email", true},
+		{[]string{"address.country"}, "address.country", true},
+		{[]string{"name.first"}, "name.first", true},
+		{[]string{"labels.team.owner"}, "labels.team.owner", true},
+		{[]string{"address..city"}, "address..city", true},
+		{[]string{"address."}, "address.", true},
+		{[]string{""}, "", true},
+	}
+
+	for _, tt := range tests {
+		err := FieldMask("UpdateMask", tt.paths, allowed)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("FieldMask(%q) error = %v, wantErr %v", tt.paths, err, tt.wantErr)
+			continue
+		}
+		if err != nil && (err.MessageKey != MsgInvalidFieldMask || err.MessageParams[Token] != tt.wantToken) {
+			t.Errorf("FieldMask(%q) = %v, Token %q, want %q", tt.paths, err.MessageKey, err.MessageParams[Token], tt.wantToken)
+		}
+	}
+}
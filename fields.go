@@ -0,0 +1,44 @@
+package rapidval
+
+// WithFirstErrorPerField makes the Validator report only the first error of every field, e.g. Required
+// without the MinLength error that follows it for an empty value. The remaining rules still run;
+// their errors are dropped. Warnings are kept as they are.
+func WithFirstErrorPerField() Option {
+	return func(v *Validator) {
+		v.firstPerField = true
+	}
+}
+
+// firstPerField removes every error whose field already has an earlier error, keeping the order of errs.
+func firstPerField(errs ValidationErrors) ValidationErrors {
+	n := 0
+	for _, err := range errs {
+		if !err.Warning && hasFieldError(errs[:n], err.Field) {
+			err.release()
+			continue
+		}
+		errs[n] = err
+		n++
+	}
+	clear(errs[n:])
+	return errs[:n]
+}
+
+func hasFieldError(errs ValidationErrors, field string) bool {
+	for _, err := range errs {
+		if !err.Warning && err.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ByField groups the errors by field, in the order they were reported, for rendering them next
+// to the inputs of a form. Errors without a field are grouped under "".
+func (ve ValidationErrors) ByField() map[string][]*ValidationError {
+	fields := make(map[string][]*ValidationError, len(ve))
+	for _, err := range ve {
+		fields[err.Field] = append(fields[err.Field], err)
+	}
+	return fields
+}
@@ -0,0 +1,76 @@
+package rapidval
+
+import (
+	"reflect"
+	"testing"
+)
+
+type perFieldForm struct {
+	Name  string
+	Email string
+}
+
+func (f *perFieldForm) Validations() P {
+	return P{
+		Required("Name", f.Name),
+		MinLength("Name", f.Name, 3),
+		Warn(MaxLength("Email", f.Email, 3)),
+		Email("Email", f.Email),
+		MinLength("Name", f.Name, 2),
+		Warn(MinLength("Email", f.Email, 20)),
+	}
+}
+
+func TestWithFirstErrorPerField(t *testing.T) {
+	tests := []struct {
+		name         string
+		form         perFieldForm
+		opts         []Option
+		wantKeys     []string
+		wantWarnings int
+	}{
+		{"all errors", perFieldForm{Email: "invalid"}, nil, []string{MsgRequired, MsgMinLength, MsgInvalidEmail, MsgMinLength}, 2},
+		{"first per field", perFieldForm{Email: "invalid"}, []Option{WithFirstErrorPerField()}, []string{MsgRequired, MsgInvalidEmail}, 2},
+		{"later rule first", perFieldForm{Name: "A", Email: "invalid"}, []Option{WithFirstErrorPerField()}, []string{MsgMinLength, MsgInvalidEmail}, 2},
+		{"valid", perFieldForm{Name: "Ada", Email: "a@b.c"}, []Option{WithFirstErrorPerField()}, nil, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := New(tt.opts...).Run(&tt.form)
+			var keys []string
+			for _, err := range res.Errors() {
+				keys = append(keys, err.MessageKey)
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) {
+				t.Errorf("Errors() keys = %v, want %v", keys, tt.wantKeys)
+			}
+			if got := len(res.Warnings()); got != tt.wantWarnings {
+				t.Errorf("len(Warnings()) = %d, want %d", got, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsByField(t *testing.T) {
+	errs := ValidationErrors{
+		{Field: "Name", MessageKey: MsgRequired},
+		{Field: "Email", MessageKey: MsgInvalidEmail},
+		{Field: "Name", MessageKey: MsgMinLength},
+		{MessageKey: MsgInternal},
+	}
+
+	got := errs.ByField()
+	want := map[string][]*ValidationError{
+		"Name":  {errs[0], errs[2]},
+		"Email": {errs[1]},
+		"":      {errs[3]},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ByField() = %v, want %v", got, want)
+	}
+
+	if got := ValidationErrors(nil).ByField(); len(got) != 0 {
+		t.Errorf("ByField() of nil = %v, want empty", got)
+	}
+}
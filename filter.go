@@ -0,0 +1,214 @@
+package rapidval
+
+import "slices"
+
+// FilterFields lists the fields a filter expression may use, with the comparison operators allowed for each,
+// in their FIQL form: "==", "!=", "=lt=", "=le=", "=gt=", "=ge=", "=in=", "=out=" or custom "=name=" operators.
+// Allowing "=lt=" also allows its RSQL alias "<", and likewise for "=le=", "=gt=" and "=ge=".
+type FilterFields map[string][]string
+
+// filterAliases maps the RSQL comparison operators to their FIQL form.
+var filterAliases = map[string]string{"<": "=lt=", "<=": "=le=", ">": "=gt=", ">=": "=ge="}
+
+// Filter validates an RSQL/FIQL filter expression such as `status==active;(price=lt=100,tag=in=(sale,new))`
+// against fields. Constraints are joined with ';' (and) and ',' (or) and can be grouped with parentheses;
+// arguments are unquoted, or quoted with single or double quotes. The keyword forms "and" and "or" are
+// not supported. An empty expression is valid.
+//
+// The first unknown field, disallowed operator or syntax error is reported as MsgInvalidFilter,
+// with the offending part of the expression as the Token param. So are groups nested more than
+// maxFilterDepth (32) levels deep, which keeps untrusted expressions from exhausting the stack.
+func Filter(field string, value string, fields FilterFields) *ValidationError {
+	if value == "" {
+		return nil
+	}
+	p := filterParser{s: value, fields: fields}
+	ok := p.or()
+	if ok && p.pos != len(p.s) {
+		ok = p.fail(p.s[p.pos:])
+	}
+	if !ok {
+		err := newError(field, MsgInvalidFilter, value)
+		err.MessageParams[Token] = p.token
+		return err
+	}
+	return nil
+}
+
+// maxFilterDepth is the deepest nesting of parenthesized groups accepted by Filter.
+const maxFilterDepth = 32
+
+type filterParser struct {
+	s      string
+	pos    int
+	depth  int
+	fields FilterFields
+	token  string
+}
+
+// fail records token as the offending part of the expression and returns false.
+func (p *filterParser) fail(token string) bool {
+	p.token = token
+	return false
+}
+
+func (p *filterParser) peek(c byte) bool {
+	return p.pos < len(p.s) && p.s[p.pos] == c
+}
+
+func (p *filterParser) or() bool {
+	for {
+		if !p.and() {
+			return false
+		}
+		if !p.peek(',') {
+			return true
+		}
+		p.pos++
+	}
+}
+
+func (p *filterParser) and() bool {
+	for {
+		if !p.constraint() {
+			return false
+		}
+		if !p.peek(';') {
+			return true
+		}
+		p.pos++
+	}
+}
+
+func (p *filterParser) constraint() bool {
+	if p.peek('(') {
+		if p.depth == maxFilterDepth {
+			return p.fail(p.s[p.pos:])
+		}
+		p.pos++
+		p.depth++
+		if !p.or() {
+			return false
+		}
+		if !p.peek(')') {
+			return p.fail(p.s[p.pos:])
+		}
+		p.pos++
+		p.depth--
+		return true
+	}
+	return p.comparison()
+}
+
+func (p *filterParser) comparison() bool {
+	start := p.pos
+	for p.pos < len(p.s) && selectorChar(p.s[p.pos]) {
+		p.pos++
+	}
+	selector := p.s[start:p.pos]
+	if selector == "" {
+		return p.fail(p.s[start:])
+	}
+	allowed, ok := p.fields[selector]
+	if !ok {
+		return p.fail(selector)
+	}
+	op, ok := p.operator()
+	if !ok {
+		return p.fail(p.s[start:])
+	}
+	if alias, ok := filterAliases[op]; ok {
+		op = alias
+	}
+	if !slices.Contains(allowed, op) {
+		return p.fail(p.s[start:p.pos])
+	}
+	return p.arguments()
+}
+
+// operator reads "==", "!=", "=name=", "<", "<=", ">" or ">=".
+func (p *filterParser) operator() (string, bool) {
+	start := p.pos
+	switch {
+	case p.peek('='):
+		p.pos++
+		for p.pos < len(p.s) && p.s[p.pos] >= 'a' && p.s[p.pos] <= 'z' {
+			p.pos++
+		}
+		if !p.peek('=') {
+			return "", false
+		}
+	case p.peek('!'):
+		p.pos++
+		if !p.peek('=') {
+			return "", false
+		}
+	case p.peek('<'), p.peek('>'):
+		if p.pos+1 < len(p.s) && p.s[p.pos+1] == '=' {
+			p.pos++
+		}
+	default:
+		return "", false
+	}
+	p.pos++
+	return p.s[start:p.pos], true
+}
+
+func (p *filterParser) arguments() bool {
+	if !p.peek('(') {
+		return p.argument()
+	}
+	p.pos++
+	for {
+		if !p.argument() {
+			return false
+		}
+		if p.peek(')') {
+			p.pos++
+			return true
+		}
+		if !p.peek(',') {
+			return p.fail(p.s[p.pos:])
+		}
+		p.pos++
+	}
+}
+
+func (p *filterParser) argument() bool {
+	start := p.pos
+	if p.peek('"') || p.peek('\'') {
+		quote := p.s[p.pos]
+		for p.pos++; p.pos < len(p.s); p.pos++ {
+			switch p.s[p.pos] {
+			case '\\':
+				p.pos++
+			case quote:
+				p.pos++
+				return true
+			}
+		}
+		return p.fail(p.s[start:])
+	}
+	for p.pos < len(p.s) && argumentChar(p.s[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		return p.fail(p.s[start:])
+	}
+	return true
+}
+
+// selectorChar reports whether c can appear in a field name: anything but whitespace,
+// quotes, parentheses, the logical operators and the characters of the comparison operators.
+func selectorChar(c byte) bool {
+	return argumentChar(c) && c != '=' && c != '!' && c != '~' && c != '<' && c != '>'
+}
+
+// argumentChar reports whether c can appear in an unquoted argument.
+func argumentChar(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '"', '\'', '(', ')', ';', ',':
+		return false
+	}
+	return true
+}
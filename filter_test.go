@@ -0,0 +1,77 @@
+package rapidval
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	fields := FilterFields{
+		"status": {"==", "!=", "=in="},
+		"price":  {"=lt=", "=ge="},
+		"name":   {"=="},
+		"tag":    {"=in=", "=out="},
+	}
+
+	tests := []struct {
+		value     string
+		wantToken string
+		wantErr   bool
+	}{
+		{"", "", false},
+		{"status==active", "", false},
+		{"status==active;price=lt=100", "", false},
+		{"status==active,status==pending", "", false},
+		{"status==active;(price=lt=100,tag=in=(sale,new))", "", false},
+		{"price<100;price>=10", "", false},
+		{`name=="John Doe"`, "", false},
+		{`name=='O\'Brien'`, "", false},
+		{"status=in=(active)", "", false},
+		{"email==a@b.c", "email", true},
+		{"name!=John", "name!=", true},
+		{"price>100", "price>", true},
+		{"status=like=act", "status=like=", true},
+		{"status=active", "status=active", true},
+		{"status==", "", true},
+		{"status==active;", "", true},
+		{"(status==active", "", true},
+		{"status==active)", ")", true},
+		{"tag=in=(sale,new", "", true},
+		{`name=="John`, `"John`, true},
+		{"status==a b", " b", true},
+		{";status==active", ";status==active", true},
+	}
+
+	for _, tt := range tests {
+		err := Filter("Filter", tt.value, fields)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Filter(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if err != nil && (err.MessageKey != MsgInvalidFilter || err.MessageParams[Token] != tt.wantToken) {
+			t.Errorf("Filter(%q) = %v, Token %q, want %q", tt.value, err.MessageKey, err.MessageParams[Token], tt.wantToken)
+		}
+	}
+}
+
+func TestFilterDepth(t *testing.T) {
+	fields := FilterFields{"status": {"=="}}
+	nested := func(n int) string {
+		return strings.Repeat("(", n) + "status==active" + strings.Repeat(")", n)
+	}
+
+	if err := Filter("Filter", nested(maxFilterDepth), fields); err != nil {
+		t.Errorf("Filter() at the maximum depth = %v, want nil", err)
+	}
+	err := Filter("Filter", nested(maxFilterDepth+1), fields)
+	if err == nil || err.MessageKey != MsgInvalidFilter {
+		t.Fatalf("Filter() beyond the maximum depth = %v, want %s", err, MsgInvalidFilter)
+	}
+	if want := nested(1); err.MessageParams[Token] != want+strings.Repeat(")", maxFilterDepth) {
+		t.Errorf("Token = %q, want the innermost group", err.MessageParams[Token])
+	}
+
+	if err := Filter("Filter", strings.Repeat("(", 1<<20), fields); err == nil {
+		t.Error("Filter() of deeply nested parentheses = nil, want an error")
+	}
+}
@@ -0,0 +1,74 @@
+package rapidval
+
+import "context"
+
+type flagsKey struct{}
+
+// WithFlags returns a copy of ctx in which the named feature flags are enabled, for use with SkipUnless.
+// Flags enabled in ctx by earlier calls stay enabled.
+func WithFlags(ctx context.Context, flags ...string) context.Context {
+	parent := flagFunc(ctx)
+	enabled := make(map[string]bool, len(flags))
+	for _, f := range flags {
+		enabled[f] = true
+	}
+	return WithFlagFunc(ctx, func(name string) bool {
+		return enabled[name] || (parent != nil && parent(name))
+	})
+}
+
+// WithFlagFunc returns a copy of ctx in which feature flags are resolved by enabled,
+// e.g. a lookup in a feature flag service for the current tenant.
+func WithFlagFunc(ctx context.Context, enabled func(name string) bool) context.Context {
+	return context.WithValue(ctx, flagsKey{}, enabled)
+}
+
+// FlagEnabled reports whether the named feature flag is enabled in ctx.
+func FlagEnabled(ctx context.Context, name string) bool {
+	enabled := flagFunc(ctx)
+	return enabled != nil && enabled(name)
+}
+
+func flagFunc(ctx context.Context) func(string) bool {
+	enabled, _ := ctx.Value(flagsKey{}).(func(string) bool)
+	return enabled
+}
+
+// SkipUnless groups rules that only apply while the named feature flag is enabled in the context
+// passed to ValidateContext, so stricter validation can be rolled out gradually:
+//
+//	rapidval.SkipUnless("strict_kyc",
+//	    rapidval.Required("TaxID", c.TaxID),
+//	    rapidval.MinLength("Address", c.Address, 10),
+//	)
+//
+// Eager rules in the group are still evaluated while P is built, but their errors are discarded.
+func SkipUnless(flag string, rules ...Rule) Rule {
+	return flagRule{flag: flag, rules: rules}
+}
+
+// SkipIf groups rules that are skipped while the named feature flag is enabled, e.g. to phase out a legacy rule.
+func SkipIf(flag string, rules ...Rule) Rule {
+	return flagRule{flag: flag, rules: rules, negate: true}
+}
+
+type flagRule struct {
+	flag   string
+	rules  []Rule
+	negate bool
+}
+
+// Check implements Rule for callers evaluating the rule on its own. It returns the first error only.
+func (f flagRule) Check(ctx context.Context) *ValidationError {
+	if errs := f.collect(ctx, &Validator{}, "", fieldPrefix{}, nil); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (f flagRule) collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	if FlagEnabled(ctx, f.flag) == f.negate {
+		return errs
+	}
+	return v.collectRules(ctx, name, f.rules, prefix, errs)
+}
@@ -0,0 +1,58 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+)
+
+type kycCustomer struct {
+	Name  string
+	TaxID string
+}
+
+func (c *kycCustomer) Validations() P {
+	return P{
+		Required("Name", c.Name),
+		SkipUnless("strict_kyc",
+			Required("TaxID", c.TaxID),
+		),
+		SkipIf("legacy_off",
+			MinLength("Name", c.Name, 3),
+		),
+	}
+}
+
+func TestSkipUnless(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{"no flags", context.Background(), []string{"Name"}},
+		{"strict", WithFlags(context.Background(), "strict_kyc"), []string{"TaxID", "Name"}},
+		{"legacy off", WithFlags(context.Background(), "legacy_off"), nil},
+		{"stacked", WithFlags(WithFlags(context.Background(), "strict_kyc"), "legacy_off"), []string{"TaxID"}},
+		{"flag func", WithFlagFunc(context.Background(), func(name string) bool { return name == "strict_kyc" }), []string{"TaxID", "Name"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verr, _ := New().ValidateContext(tt.ctx, &kycCustomer{Name: "Al"}).(ValidationErrors)
+			if len(verr) != len(tt.want) {
+				t.Fatalf("ValidateContext() = %v, want errors on %v", verr, tt.want)
+			}
+			for i, field := range tt.want {
+				if verr[i].Field != field {
+					t.Errorf("errs[%d].Field = %v, want %v", i, verr[i].Field, field)
+				}
+			}
+		})
+	}
+}
+
+func TestFlagEnabled(t *testing.T) {
+	ctx := WithFlags(context.Background(), "a")
+	if !FlagEnabled(ctx, "a") || FlagEnabled(ctx, "b") || FlagEnabled(context.Background(), "a") {
+		t.Error("FlagEnabled() returned unexpected results")
+	}
+}
@@ -0,0 +1,60 @@
+package rapidval
+
+// Area is a geographic region that coordinates can be checked against, e.g. a BoundingBox, a Polygon,
+// or a service area backed by a geospatial index.
+type Area interface {
+	Contains(lat, lng float64) bool
+}
+
+// BoundingBox is a rectangular Area given by its south-west and north-east corners in degrees.
+// A box with MinLng greater than MaxLng crosses the antimeridian.
+type BoundingBox struct {
+	MinLat, MinLng float64
+	MaxLat, MaxLng float64
+}
+
+// Contains reports whether the coordinates lie within the box, edges included.
+func (b BoundingBox) Contains(lat, lng float64) bool {
+	if lat < b.MinLat || lat > b.MaxLat {
+		return false
+	}
+	if b.MinLng <= b.MaxLng {
+		return lng >= b.MinLng && lng <= b.MaxLng
+	}
+	return lng >= b.MinLng || lng <= b.MaxLng
+}
+
+// Point is a pair of coordinates in degrees.
+type Point struct {
+	Lat, Lng float64
+}
+
+// Polygon is an Area given by its vertices in order; the last vertex connects back to the first.
+// Coordinates are treated as planar, which is accurate enough for city-sized service areas
+// that do not cross the antimeridian.
+type Polygon []Point
+
+// Contains reports whether the coordinates lie inside the polygon, using the even-odd rule.
+func (p Polygon) Contains(lat, lng float64) bool {
+	inside := false
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := p[i], p[j]
+		if (a.Lat > lat) != (b.Lat > lat) && lng < (b.Lng-a.Lng)*(lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// WithinArea validates that the coordinates lie within area.
+func WithinArea(field string, lat, lng float64, area Area) *ValidationError {
+	if !area.Contains(lat, lng) {
+		return newError(field, MsgOutsideArea, Point{Lat: lat, Lng: lng})
+	}
+	return nil
+}
+
+// WithinBoundingBox validates that the coordinates lie within box.
+func WithinBoundingBox(field string, lat, lng float64, box BoundingBox) *ValidationError {
+	return WithinArea(field, lat, lng, box)
+}
@@ -0,0 +1,44 @@
+package rapidval
+
+import "testing"
+
+func TestWithinArea(t *testing.T) {
+	istanbul := BoundingBox{MinLat: 40.8, MinLng: 28.5, MaxLat: 41.3, MaxLng: 29.4}
+	fiji := BoundingBox{MinLat: -21, MinLng: 177, MaxLat: -12, MaxLng: -178}
+	square := Polygon{{0, 0}, {0, 10}, {10, 10}, {10, 0}}
+	ell := Polygon{{0, 0}, {0, 10}, {5, 10}, {5, 5}, {10, 5}, {10, 0}}
+
+	tests := []struct {
+		name     string
+		area     Area
+		lat, lng float64
+		wantErr  bool
+	}{
+		{"inside box", istanbul, 41.01, 28.97, false},
+		{"box edge", istanbul, 40.8, 28.5, false},
+		{"outside box", istanbul, 39.93, 32.85, true},
+		{"antimeridian east", fiji, -17, 178, false},
+		{"antimeridian west", fiji, -17, -179, false},
+		{"antimeridian outside", fiji, -17, 170, true},
+		{"inside polygon", square, 5, 5, false},
+		{"outside polygon", square, 15, 5, true},
+		{"concave inside", ell, 2, 8, false},
+		{"concave notch", ell, 8, 8, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := WithinArea("Location", tt.lat, tt.lng, tt.area)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WithinArea(%v, %v) error = %v, wantErr %v", tt.lat, tt.lng, err, tt.wantErr)
+			}
+			if err != nil && (err.MessageKey != MsgOutsideArea || err.CurrentValue != (Point{tt.lat, tt.lng})) {
+				t.Errorf("WithinArea(%v, %v) = %v %v", tt.lat, tt.lng, err.MessageKey, err.CurrentValue)
+			}
+		})
+	}
+
+	if err := WithinBoundingBox("Location", 41.01, 28.97, istanbul); err != nil {
+		t.Errorf("WithinBoundingBox() = %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package rapidval
+
+import (
+	"mime"
+	"strconv"
+	"strings"
+)
+
+// HTTPMethod validates that value is one of the standard HTTP request methods, in upper case.
+// The methods are spelled out rather than taken from net/http, which would add the HTTP stack to
+// every program using the package, including WebAssembly builds.
+func HTTPMethod(field string, value string) *ValidationError {
+	switch value {
+	case "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "CONNECT", "OPTIONS", "TRACE":
+		return nil
+	}
+	return newError(field, MsgInvalidHTTPMethod, value)
+}
+
+// HTTPStatusCode validates an HTTP status code between 100 and 599. classes restricts the accepted
+// status classes by their first digit, e.g. HTTPStatusCode("RedirectStatus", code, 3) or
+// HTTPStatusCode("SuccessStatus", code, 2, 3) for "must be 2xx-3xx".
+func HTTPStatusCode(field string, value int, classes ...int) *ValidationError {
+	ok := value >= 100 && value <= 599
+	if ok && len(classes) > 0 {
+		ok = false
+		for _, c := range classes {
+			if value/100 == c {
+				ok = true
+				break
+			}
+		}
+	}
+	if !ok {
+		err := newError(field, MsgInvalidHTTPStatus, value)
+		if len(classes) > 0 {
+			allowed := make([]string, len(classes))
+			for i, c := range classes {
+				allowed[i] = strconv.Itoa(c) + "xx"
+			}
+			err.MessageParams[Allowed] = allowed
+		}
+		return err
+	}
+	return nil
+}
+
+// MIMEType validates a media type such as "application/json" or "text/html; charset=utf-8".
+// If allowed is not empty, the type without parameters must also match one of its entries, which may use
+// a wildcard subtype such as "image/*", or be "*/*" to accept any valid type; otherwise MsgOneOf is reported.
+// Matching is case-insensitive.
+func MIMEType(field string, value string, allowed ...string) *ValidationError {
+	mediatype, _, err := mime.ParseMediaType(value)
+	if err != nil || strings.Count(mediatype, "/") != 1 || strings.HasPrefix(mediatype, "/") || strings.HasSuffix(mediatype, "/") {
+		return newError(field, MsgInvalidMIMEType, value)
+	}
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, a := range allowed {
+		a = strings.ToLower(a)
+		if a == mediatype || a == "*/*" || strings.HasSuffix(a, "/*") && strings.HasPrefix(mediatype, a[:len(a)-1]) {
+			return nil
+		}
+	}
+	verr := newError(field, MsgOneOf, value)
+	verr.MessageParams[Allowed] = allowed
+	return verr
+}
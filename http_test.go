@@ -0,0 +1,97 @@
+package rapidval
+
+import "testing"
+
+func TestHTTPMethod(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"GET", false},
+		{"PATCH", false},
+		{"OPTIONS", false},
+		{"get", true},
+		{"FETCH", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		err := HTTPMethod("Method", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("HTTPMethod(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+func TestHTTPStatusCode(t *testing.T) {
+	tests := []struct {
+		value   int
+		classes []int
+		wantErr bool
+	}{
+		{200, nil, false},
+		{100, nil, false},
+		{599, nil, false},
+		{99, nil, true},
+		{600, nil, true},
+		{204, []int{2, 3}, false},
+		{301, []int{2, 3}, false},
+		{404, []int{2, 3}, true},
+		{302, []int{3}, false},
+		{200, []int{3}, true},
+		{700, []int{7}, true},
+	}
+
+	for _, tt := range tests {
+		err := HTTPStatusCode("Status", tt.value, tt.classes...)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("HTTPStatusCode(%d, %v) error = %v, wantErr %v", tt.value, tt.classes, err, tt.wantErr)
+		}
+	}
+
+	tr := NewTranslator()
+	if got := tr.Translate(HTTPStatusCode("Status", 404, 2, 3)); got != "Status geçerli bir HTTP durum kodu olmalıdır (2xx, 3xx)" {
+		t.Errorf("Translate() = %q", got)
+	}
+	if got := tr.Translate(HTTPStatusCode("Status", 42)); got != "Status geçerli bir HTTP durum kodu olmalıdır" {
+		t.Errorf("Translate() = %q", got)
+	}
+}
+
+func TestMIMEType(t *testing.T) {
+	tests := []struct {
+		value   string
+		allowed []string
+		wantKey string
+	}{
+		{"application/json", nil, ""},
+		{"text/html; charset=utf-8", nil, ""},
+		{"application/vnd.api+json", nil, ""},
+		{"Image/PNG", []string{"image/*"}, ""},
+		{"image/png", []string{"application/pdf", "image/*"}, ""},
+		{"application/pdf", []string{"application/PDF"}, ""},
+		{"application/json", []string{"image/*"}, MsgOneOf},
+		{"imagery/png", []string{"image/*"}, MsgOneOf},
+		{"application/octet-stream", []string{"image/*", "*/*"}, ""},
+		{"text/", []string{"*/*"}, MsgInvalidMIMEType},
+		{"text", nil, MsgInvalidMIMEType},
+		{"text/", nil, MsgInvalidMIMEType},
+		{"/html", nil, MsgInvalidMIMEType},
+		{"text/html/x", nil, MsgInvalidMIMEType},
+		{"text /html", nil, MsgInvalidMIMEType},
+		{"", nil, MsgInvalidMIMEType},
+	}
+
+	for _, tt := range tests {
+		err := MIMEType("ContentType", tt.value, tt.allowed...)
+		if tt.wantKey == "" {
+			if err != nil {
+				t.Errorf("MIMEType(%q, %v) = %v, want nil", tt.value, tt.allowed, err)
+			}
+			continue
+		}
+		if err == nil || err.MessageKey != tt.wantKey {
+			t.Errorf("MIMEType(%q, %v) = %v, want %s", tt.value, tt.allowed, err, tt.wantKey)
+		}
+	}
+}
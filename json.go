@@ -0,0 +1,92 @@
+package rapidval
+
+import "encoding/json"
+
+// jsonError is the JSON representation of a ValidationError.
+type jsonError struct {
+	Field   string                     `json:"field,omitempty"`
+	Key     string                     `json:"key"`
+	Params  map[string]json.RawMessage `json:"params,omitempty"`
+	Message string                     `json:"message,omitempty"`
+	Warning bool                       `json:"warning,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. The error is encoded as
+//
+//	{"field": "Age", "key": "validation.between", "params": {"Min": 18, "Max": 100}}
+//
+// with "warning": true for warnings. The Field param is left out of params, as it repeats field,
+// and so is the Value param, the invalid input, which responses should not echo back unless
+// asked to with TranslatedErrors.WithValues. Params that cannot be encoded, such as functions,
+// are left out as well, and Cause is not encoded. Use ValidationErrors.Translated to include
+// translated messages.
+func (ve *ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ve.json(nil, false))
+}
+
+func (ve *ValidationError) json(tr *Translator, values bool) jsonError {
+	e := jsonError{Field: ve.Field, Key: ve.MessageKey, Params: ve.JSONParams(values), Warning: ve.Warning}
+	if tr != nil {
+		e.Message = tr.Translate(ve)
+	}
+	return e
+}
+
+// JSONParams returns the message params of the error encoded as by MarshalJSON, without Field,
+// without Value unless values is true, and without params that cannot be encoded. It is meant for
+// error bodies built by hand, such as the envelopes of rapidvalhttp, and is nil if no param is left.
+func (ve *ValidationError) JSONParams(values bool) map[string]json.RawMessage {
+	var params map[string]json.RawMessage
+	for k, v := range ve.MessageParams {
+		if k == Field || k == Value && !values {
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		if params == nil {
+			params = make(map[string]json.RawMessage, len(ve.MessageParams))
+		}
+		params[k] = b
+	}
+	return params
+}
+
+// MarshalJSON implements json.Marshaler. The errors are encoded as a JSON array, which is empty
+// rather than null for nil errors.
+func (ve ValidationErrors) MarshalJSON() ([]byte, error) {
+	return ve.Translated(nil).MarshalJSON()
+}
+
+// TranslatedErrors encodes validation errors to JSON with their translated messages.
+type TranslatedErrors struct {
+	errs   ValidationErrors
+	tr     *Translator
+	values bool
+}
+
+// Translated returns the errors for encoding with a "message" member translated with tr, e.g.
+//
+//	json.NewEncoder(w).Encode(map[string]interface{}{"errors": errs.Translated(tr)})
+//
+// A nil tr encodes the errors without messages, like ValidationErrors.MarshalJSON.
+func (ve ValidationErrors) Translated(tr *Translator) TranslatedErrors {
+	return TranslatedErrors{errs: ve, tr: tr}
+}
+
+// WithValues includes the Value param in the encoded errors, for consumers that may see the input,
+// such as an internal service correcting its records. Values of Sensitive rules stay redacted.
+func (t TranslatedErrors) WithValues() TranslatedErrors {
+	t.values = true
+	return t
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t TranslatedErrors) MarshalJSON() ([]byte, error) {
+	list := make([]jsonError, len(t.errs))
+	for i, err := range t.errs {
+		list[i] = err.json(t.tr, t.values)
+	}
+	return json.Marshal(list)
+}
@@ -0,0 +1,58 @@
+package rapidval
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	between := Between("Age", 12, 18, 100)
+	password := Sensitive(MinLength("Password", "secret", 12)).Check(context.Background())
+	weak := &ValidationError{Field: "Name", MessageKey: "custom.weak", Warning: true}
+	general := &ValidationError{MessageKey: MsgInternal}
+	custom := &ValidationError{Field: "Code", MessageKey: "custom.code", MessageParams: map[string]interface{}{
+		Field: "Code", Value: "x", "Check": func(string) bool { return false }, "Length": 3,
+	}}
+
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{"error", between, `{"field":"Age","key":"validation.between","params":{"Max":100,"Min":18}}`},
+		{"unencodable param", custom, `{"field":"Code","key":"custom.code","params":{"Length":3}}`},
+		{"warning", weak, `{"field":"Name","key":"custom.weak","warning":true}`},
+		{"no field", general, `{"key":"validation.internal"}`},
+		{"errors", ValidationErrors{weak, general}, `[{"field":"Name","key":"custom.weak","warning":true},{"key":"validation.internal"}]`},
+		{"nil errors", ValidationErrors(nil), `[]`},
+		{"translated", ValidationErrors{between}.Translated(NewTranslator()), `[{"field":"Age","key":"validation.between","params":{"Max":100,"Min":18},"message":"Age 18 ile 100 arasında olmalıdır"}]`},
+		{"values", ValidationErrors{between, password}.Translated(nil).WithValues(), `[{"field":"Age","key":"validation.between","params":{"Max":100,"Min":18,"Value":12}},{"field":"Password","key":"validation.min_length","params":{"Min":12,"Value":"[REDACTED]"}}]`},
+		{"nil translator", ValidationErrors{general}.Translated(nil), `[{"key":"validation.internal"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if got := string(b); got != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONParams(t *testing.T) {
+	between := Between("Age", 12, 18, 100)
+	if got := between.JSONParams(false); len(got) != 2 || string(got[Min]) != "18" || string(got[Max]) != "100" {
+		t.Errorf("JSONParams(false) = %s, want Min and Max", got)
+	}
+	if got := between.JSONParams(true); string(got[Value]) != "12" {
+		t.Errorf("JSONParams(true)[Value] = %s, want 12", got[Value])
+	}
+	if got := (&ValidationError{Field: "Name", MessageKey: MsgRequired}).JSONParams(true); got != nil {
+		t.Errorf("JSONParams() = %s, want nil", got)
+	}
+}
@@ -0,0 +1,81 @@
+package rapidval
+
+import "strings"
+
+// DNS1123Label validates a DNS label as defined by RFC 1123 and used by Kubernetes for most resource
+// names: at most 63 lowercase alphanumeric characters or '-', starting and ending with an alphanumeric character.
+func DNS1123Label(field string, value string) *ValidationError {
+	if !dns1123Label(value) {
+		err := newError(field, MsgInvalidDNSLabel, value)
+		err.MessageParams[Max] = 63
+		return err
+	}
+	return nil
+}
+
+// DNS1123Subdomain validates a DNS subdomain as defined by RFC 1123 and used by Kubernetes for names
+// such as those of ConfigMaps and Secrets: at most 253 characters of DNS1123Label labels separated by dots.
+func DNS1123Subdomain(field string, value string) *ValidationError {
+	if len(value) > 253 || !dns1123Subdomain(value) {
+		err := newError(field, MsgInvalidDNSSubdomain, value)
+		err.MessageParams[Max] = 253
+		return err
+	}
+	return nil
+}
+
+// K8sLabelValue validates a Kubernetes label value: empty, or at most 63 alphanumeric characters,
+// '-', '_' or '.', starting and ending with an alphanumeric character.
+func K8sLabelValue(field string, value string) *ValidationError {
+	if !labelValue(value) {
+		err := newError(field, MsgInvalidLabelValue, value)
+		err.MessageParams[Max] = 63
+		return err
+	}
+	return nil
+}
+
+func dns1123Label(s string) bool {
+	if s == "" || len(s) > 63 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'a' && c <= 'z' || c >= '0' && c <= '9':
+		case c == '-' && i > 0 && i < len(s)-1:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func dns1123Subdomain(s string) bool {
+	for {
+		label, rest, more := strings.Cut(s, ".")
+		if !dns1123Label(label) {
+			return false
+		}
+		if !more {
+			return true
+		}
+		s = rest
+	}
+}
+
+func labelValue(s string) bool {
+	if len(s) > 63 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9':
+		case (c == '-' || c == '_' || c == '.') && i > 0 && i < len(s)-1:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package rapidval
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKubernetesNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    func(field, value string) *ValidationError
+		value   string
+		wantKey string
+	}{
+		{"label", DNS1123Label, "my-app-1", ""},
+		{"label single char", DNS1123Label, "a", ""},
+		{"label max length", DNS1123Label, strings.Repeat("a", 63), ""},
+		{"label too long", DNS1123Label, strings.Repeat("a", 64), MsgInvalidDNSLabel},
+		{"label empty", DNS1123Label, "", MsgInvalidDNSLabel},
+		{"label uppercase", DNS1123Label, "My-App", MsgInvalidDNSLabel},
+		{"label leading dash", DNS1123Label, "-app", MsgInvalidDNSLabel},
+		{"label dot", DNS1123Label, "my.app", MsgInvalidDNSLabel},
+		{"subdomain", DNS1123Subdomain, "config.my-app.example", ""},
+		{"subdomain single label", DNS1123Subdomain, "app", ""},
+		{"subdomain empty label", DNS1123Subdomain, "my..app", MsgInvalidDNSSubdomain},
+		{"subdomain trailing dot", DNS1123Subdomain, "my.app.", MsgInvalidDNSSubdomain},
+		{"subdomain too long", DNS1123Subdomain, strings.Repeat("abc.", 63) + "ab", MsgInvalidDNSSubdomain},
+		{"label value", K8sLabelValue, "Release_1.2-rc", ""},
+		{"label value empty", K8sLabelValue, "", ""},
+		{"label value trailing dot", K8sLabelValue, "v1.", MsgInvalidLabelValue},
+		{"label value slash", K8sLabelValue, "team/a", MsgInvalidLabelValue},
+		{"label value too long", K8sLabelValue, strings.Repeat("a", 64), MsgInvalidLabelValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule("Name", tt.value)
+			if tt.wantKey == "" {
+				if err != nil {
+					t.Errorf("%q: unexpected error %v", tt.value, err)
+				}
+				return
+			}
+			if err == nil || err.MessageKey != tt.wantKey {
+				t.Errorf("%q: error = %v, want %s", tt.value, err, tt.wantKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package rapidval
+
+import "strings"
+
+// LanguageTag validates the syntax of a BCP 47 language tag such as "tr", "en-US", "zh-Hant-TW"
+// or "de-CH-1996". Subtags are matched case-insensitively. Only the syntax is checked, not whether
+// the subtags are registered; irregular grandfathered tags such as "i-klingon" are rejected.
+func LanguageTag(field string, value string) *ValidationError {
+	if !languageTag(value) {
+		return newError(field, MsgInvalidLanguageTag, value)
+	}
+	return nil
+}
+
+// languageTag parses s following the langtag production of RFC 5646:
+// language[-extlang][-script][-region](-variant)*(-extension)*[-privateuse], or privateuse alone.
+func languageTag(s string) bool {
+	subtags := strings.Split(s, "-")
+	if subtags[0] == "x" || subtags[0] == "X" {
+		return privateUse(subtags[1:])
+	}
+	i := 0
+	lang := subtags[i]
+	if !isAlpha(lang) || len(lang) < 2 || len(lang) > 8 {
+		return false
+	}
+	i++
+	if len(lang) <= 3 {
+		for n := 0; n < 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]); n++ {
+			i++
+		}
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		i++
+	}
+	if i < len(subtags) && (len(subtags[i]) == 2 && isAlpha(subtags[i]) || len(subtags[i]) == 3 && digits(subtags[i])) {
+		i++
+	}
+	for i < len(subtags) && variant(subtags[i]) {
+		i++
+	}
+	for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" && subtags[i] != "X" && isAlphanumeric(subtags[i]) {
+		i++
+		n := 0
+		for i < len(subtags) && len(subtags[i]) >= 2 && len(subtags[i]) <= 8 && isAlphanumeric(subtags[i]) {
+			i++
+			n++
+		}
+		if n == 0 {
+			return false
+		}
+	}
+	if i < len(subtags) && (subtags[i] == "x" || subtags[i] == "X") {
+		return privateUse(subtags[i+1:])
+	}
+	return i == len(subtags)
+}
+
+// variant reports whether s is 5 to 8 alphanumeric characters, or 4 starting with a digit.
+func variant(s string) bool {
+	if !isAlphanumeric(s) {
+		return false
+	}
+	return len(s) >= 5 && len(s) <= 8 || len(s) == 4 && s[0] >= '0' && s[0] <= '9'
+}
+
+func privateUse(subtags []string) bool {
+	if len(subtags) == 0 {
+		return false
+	}
+	for _, s := range subtags {
+		if s == "" || len(s) > 8 || !isAlphanumeric(s) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package rapidval
+
+import "testing"
+
+func TestLanguageTag(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"tr", false},
+		{"en-US", false},
+		{"tr-TR", false},
+		{"zh-Hant-TW", false},
+		{"es-419", false},
+		{"de-CH-1996", false},
+		{"sl-rozaj-biske", false},
+		{"zh-yue-HK", false},
+		{"en-US-u-ca-gregory", false},
+		{"de-DE-x-phonebk", false},
+		{"x-whatever", false},
+		{"EN-us", false},
+		{"", true},
+		{"e", true},
+		{"en_US", true},
+		{"en-", true},
+		{"en--US", true},
+		{"english-US-toolongsubtag", true},
+		{"en-US-u", true},
+		{"en-x", true},
+		{"en-US-1", true},
+		{"i-klingon", true},
+		{"123", true},
+	}
+
+	for _, tt := range tests {
+		err := LanguageTag("Locale", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("LanguageTag(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgInvalidLanguageTag {
+			t.Errorf("LanguageTag(%q) message key = %v", tt.value, err.MessageKey)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package rapidval
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// Counters counts validations and failures by message key and by validated type, for services
+// without a metrics stack. Its String method returns them as JSON, so Counters implements
+// expvar.Var and can be published with the rapidvalexpvar package:
+//
+//	{"validations": 1200, "failures": 37, "keys": {"validation.required": 30, ...}, "types": {"examples.User": 25, ...}}
+//
+// "validations" counts validated values and "failures" those that failed. "keys" counts errors by message key,
+// after WithKeyPrefix is applied, and "types" counts failed validations by type. Warnings are not counted.
+// The zero value is ready to use, and Counters may be shared by several Validators.
+type Counters struct {
+	mu          sync.Mutex
+	validations int64
+	failures    int64
+	keys        map[string]int64
+	types       map[string]int64
+}
+
+// WithCounters makes the Validator count its validations and failures in c.
+func WithCounters(c *Counters) Option {
+	return func(v *Validator) {
+		v.metrics = c
+	}
+}
+
+func (c *Counters) record(name string, errs ValidationErrors) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.validations++
+	if len(errs) == 0 {
+		return
+	}
+	c.failures++
+	if c.keys == nil {
+		c.keys = map[string]int64{}
+		c.types = map[string]int64{}
+	}
+	c.types[strings.TrimSuffix(name, ".Validations")]++
+	for _, err := range errs {
+		c.keys[err.MessageKey]++
+	}
+}
+
+// String returns the counters as a JSON object.
+func (c *Counters) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, _ := json.Marshal(struct {
+		Validations int64            `json:"validations"`
+		Failures    int64            `json:"failures"`
+		Keys        map[string]int64 `json:"keys"`
+		Types       map[string]int64 `json:"types"`
+	}{c.validations, c.failures, c.keys, c.types})
+	return string(b)
+}
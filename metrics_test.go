@@ -0,0 +1,41 @@
+package rapidval
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWithCounters(t *testing.T) {
+	c := new(Counters)
+	v := New(WithCounters(c), WithKeyPrefix("app."))
+	other := New(WithCounters(c))
+
+	v.Validate(&testStruct3{Name: "", Email: "invalid", Age: 10})
+	v.Validate(&testStruct3{Name: "Ada", Email: "ada@example.com", Age: 30})
+	other.Validate(&testStruct3{Name: "Ada", Email: "ada@example.com", Age: 10})
+
+	var got struct {
+		Validations int
+		Failures    int
+		Keys        map[string]int
+		Types       map[string]int
+	}
+	if err := json.Unmarshal([]byte(c.String()), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Validations != 3 || got.Failures != 2 {
+		t.Errorf("validations = %d, failures = %d, want 3, 2", got.Validations, got.Failures)
+	}
+	wantKeys := map[string]int{"app." + MsgMinLength: 1, "app." + MsgInvalidEmail: 1, "app." + MsgBetween: 1, MsgBetween: 1}
+	for key, n := range wantKeys {
+		if got.Keys[key] != n {
+			t.Errorf("keys[%q] = %d, want %d (keys: %v)", key, got.Keys[key], n, got.Keys)
+		}
+	}
+	if len(got.Keys) != len(wantKeys) {
+		t.Errorf("keys = %v, want %v", got.Keys, wantKeys)
+	}
+	if got.Types["rapidval.testStruct3"] != 2 {
+		t.Errorf("types = %v, want rapidval.testStruct3: 2", got.Types)
+	}
+}
@@ -0,0 +1,82 @@
+package rapidval
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in the minor unit of its currency, e.g. {Amount: 1250, Currency: "USD"} for 12.50 USD,
+// so limits can be compared without float arithmetic.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// currencyExponents lists the ISO 4217 currencies whose minor unit is not 1/100 of the major unit.
+var currencyExponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// String formats the amount in major units followed by the currency code, e.g. "12.50 USD" or "500 JPY".
+func (m Money) String() string {
+	exp, ok := currencyExponents[m.Currency]
+	if !ok {
+		exp = 2
+	}
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	s := strconv.FormatInt(amount, 10)
+	if exp > 0 {
+		if len(s) <= exp {
+			s = strings.Repeat("0", exp-len(s)+1) + s
+		}
+		s = s[:len(s)-exp] + "." + s[len(s)-exp:]
+	}
+	return sign + s + " " + m.Currency
+}
+
+// AmountBetween validates that an amount in minor units lies between min and max, inclusive.
+// The currency must match the currency of the limits; otherwise MsgCurrencyMismatch is reported
+// with the expected currency as the Allowed param. The Min and Max params are Money values.
+func AmountBetween(field string, amountMinor int64, currency string, min, max Money) *ValidationError {
+	value := Money{Amount: amountMinor, Currency: currency}
+	if currency != min.Currency || currency != max.Currency {
+		err := newError(field, MsgCurrencyMismatch, value)
+		err.MessageParams[Allowed] = min.Currency
+		return err
+	}
+	if amountMinor < min.Amount || amountMinor > max.Amount {
+		err := newError(field, MsgAmountBetween, value)
+		err.MessageParams[Min] = min
+		err.MessageParams[Max] = max
+		return err
+	}
+	return nil
+}
+
+// BasisPoints validates a value in basis points, between 0 (0%) and 10000 (100%).
+func BasisPoints(field string, value int) *ValidationError {
+	if value < 0 || value > 10000 {
+		err := newError(field, MsgBetween, value)
+		err.MessageParams[Min] = 0
+		err.MessageParams[Max] = 10000
+		return err
+	}
+	return nil
+}
+
+// TaxRate validates a tax rate given as a fraction between 0 and 1, e.g. 0.2 for 20%.
+// jurisdiction, e.g. "TR" or "US-CA", is not interpreted; it is passed to the message as the Jurisdiction param.
+func TaxRate(field string, value float64, jurisdiction string) *ValidationError {
+	if !(value >= 0 && value <= 1) {
+		err := newError(field, MsgInvalidTaxRate, value)
+		err.MessageParams[Jurisdiction] = jurisdiction
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package rapidval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{Money{1250, "USD"}, "12.50 USD"},
+		{Money{5, "EUR"}, "0.05 EUR"},
+		{Money{-199, "TRY"}, "-1.99 TRY"},
+		{Money{500, "JPY"}, "500 JPY"},
+		{Money{1500, "KWD"}, "1.500 KWD"},
+		{Money{0, "USD"}, "0.00 USD"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.money.String(); got != tt.want {
+			t.Errorf("Money%v.String() = %q, want %q", tt.money, got, tt.want)
+		}
+	}
+}
+
+func TestAmountBetween(t *testing.T) {
+	min, max := Money{100, "USD"}, Money{1000000, "USD"}
+
+	tests := []struct {
+		name     string
+		amount   int64
+		currency string
+		wantKey  string
+	}{
+		{"within", 5000, "USD", ""},
+		{"min edge", 100, "USD", ""},
+		{"max edge", 1000000, "USD", ""},
+		{"below", 99, "USD", MsgAmountBetween},
+		{"above", 1000001, "USD", MsgAmountBetween},
+		{"currency mismatch", 5000, "EUR", MsgCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AmountBetween("Amount", tt.amount, tt.currency, min, max)
+			if tt.wantKey == "" {
+				if err != nil {
+					t.Errorf("AmountBetween() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.MessageKey != tt.wantKey {
+				t.Errorf("AmountBetween() = %v, want %s", err, tt.wantKey)
+			}
+		})
+	}
+
+	got := NewTranslator().Translate(AmountBetween("Amount", 50, "USD", min, max))
+	if want := "Amount 1.00 USD ile 10000.00 USD arasında olmalıdır"; got != want {
+		t.Errorf("Translate() = %q, want %q", got, want)
+	}
+}
+
+func TestBasisPoints(t *testing.T) {
+	tests := []struct {
+		value   int
+		wantErr bool
+	}{
+		{0, false},
+		{250, false},
+		{10000, false},
+		{-1, true},
+		{10001, true},
+	}
+
+	for _, tt := range tests {
+		err := BasisPoints("Fee", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("BasisPoints(%d) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && (err.MessageKey != MsgBetween || err.MessageParams[Max] != 10000) {
+			t.Errorf("BasisPoints(%d) = %v %v", tt.value, err.MessageKey, err.MessageParams)
+		}
+	}
+}
+
+func TestTaxRate(t *testing.T) {
+	tests := []struct {
+		value   float64
+		wantErr bool
+	}{
+		{0, false},
+		{0.18, false},
+		{1, false},
+		{-0.01, true},
+		{18, true},
+		{math.NaN(), true},
+	}
+
+	for _, tt := range tests {
+		err := TaxRate("VAT", tt.value, "TR")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("TaxRate(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && (err.MessageKey != MsgInvalidTaxRate || err.MessageParams[Jurisdiction] != "TR") {
+			t.Errorf("TaxRate(%v) = %v %v", tt.value, err.MessageKey, err.MessageParams)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package rapidval
+
+import (
+	"slices"
+	"strings"
+)
+
+// Fields reported by Pagination.
+const (
+	PageField    = "Page"
+	PerPageField = "PerPage"
+)
+
+// Pagination returns the rules for the page and page size parameters of list endpoints: page must be
+// at least 1 and perPage between 1 and maxPerPage. Errors are reported on PageField and PerPageField.
+// The group can be nested in the P of a query type:
+//
+//	func (q *ListOrders) Validations() rapidval.P {
+//	    return rapidval.P{
+//	        rapidval.Pagination(q.Page, q.PerPage, 100),
+//	        rapidval.MaxLength("Query", q.Query, 100),
+//	    }
+//	}
+func Pagination(page, perPage int, maxPerPage int) P {
+	var rules P
+	if page < 1 {
+		err := newError(PageField, MsgMin, page)
+		err.MessageParams[Min] = 1
+		rules = append(rules, err)
+	}
+	if err := Between(PerPageField, perPage, 1, maxPerPage); err != nil {
+		rules = append(rules, err)
+	}
+	return rules
+}
+
+// SortExpr validates a comma-separated sort expression such as "-created_at,name", where each field may be
+// prefixed with '-' for descending or '+' for ascending order and must be one of allowedFields.
+// An empty expression is valid. The first invalid or repeated token is available as the Token param
+// and the allowed fields as the Allowed param.
+func SortExpr(field string, value string, allowedFields []string) *ValidationError {
+	if value == "" {
+		return nil
+	}
+	seen := make([]string, 0, 4)
+	for rest := value; ; {
+		token, next, more := strings.Cut(rest, ",")
+		name := token
+		if name != "" && (name[0] == '-' || name[0] == '+') {
+			name = name[1:]
+		}
+		if !slices.Contains(allowedFields, name) || slices.Contains(seen, name) {
+			return sortError(field, value, token, allowedFields)
+		}
+		seen = append(seen, name)
+		if !more {
+			return nil
+		}
+		rest = next
+	}
+}
+
+func sortError(field, value, token string, allowedFields []string) *ValidationError {
+	err := newError(field, MsgInvalidSort, value)
+	err.MessageParams[Token] = token
+	err.MessageParams[Allowed] = allowedFields
+	return err
+}
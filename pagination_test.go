@@ -0,0 +1,94 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+)
+
+type listOrders struct {
+	Page, PerPage int
+	Query         string
+}
+
+func (q *listOrders) Validations() P {
+	return P{
+		Pagination(q.Page, q.PerPage, 100),
+		MaxLength("Query", q.Query, 10),
+	}
+}
+
+func TestPagination(t *testing.T) {
+	tests := []struct {
+		name string
+		q    listOrders
+		want map[string]string
+	}{
+		{"valid", listOrders{Page: 1, PerPage: 20}, nil},
+		{"max per page", listOrders{Page: 3, PerPage: 100}, nil},
+		{"page zero", listOrders{Page: 0, PerPage: 20}, map[string]string{PageField: MsgMin}},
+		{"per page too large", listOrders{Page: 1, PerPage: 101}, map[string]string{PerPageField: MsgBetween}},
+		{"all invalid", listOrders{Page: -1, PerPage: 0, Query: "a very long query"}, map[string]string{
+			PageField:    MsgMin,
+			PerPageField: MsgBetween,
+			"Query":      MsgMaxLength,
+		}},
+	}
+
+	v := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(&tt.q)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			verr, ok := err.(ValidationErrors)
+			if !ok || len(verr) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want %d errors", err, len(tt.want))
+			}
+			for _, e := range verr {
+				if tt.want[e.Field] != e.MessageKey {
+					t.Errorf("field %s: message key = %v, want %v", e.Field, e.MessageKey, tt.want[e.Field])
+				}
+			}
+		})
+	}
+
+	if err := Pagination(0, 0, 10).Check(context.Background()); err == nil || err.Field != PageField {
+		t.Errorf("P.Check() = %v, want the Page error", err)
+	}
+}
+
+func TestSortExpr(t *testing.T) {
+	allowed := []string{"created_at", "name", "price"}
+
+	tests := []struct {
+		value     string
+		wantToken string
+		wantErr   bool
+	}{
+		{"", "", false},
+		{"name", "", false},
+		{"-created_at,name", "", false},
+		{"+price,-name", "", false},
+		{"-created_at,email", "email", true},
+		{"name,-name", "-name", true},
+		{"name,", "", true},
+		{"--name", "--name", true},
+		{"-+name", "-+name", true},
+		{" name", " name", true},
+	}
+
+	for _, tt := range tests {
+		err := SortExpr("Sort", tt.value, allowed)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SortExpr(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if err != nil && (err.MessageKey != MsgInvalidSort || err.MessageParams[Token] != tt.wantToken) {
+			t.Errorf("SortExpr(%q) = %v, Token %q, want %q", tt.value, err.MessageKey, err.MessageParams[Token], tt.wantToken)
+		}
+	}
+}
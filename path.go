@@ -0,0 +1,179 @@
+package rapidval
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Path is the structured form of the field of a ValidationError, so nested errors can be addressed
+// without parsing dots and brackets, e.g. to map them to protobuf field paths or JSON Pointers.
+// "Items[2].Price" is Path{{Name: "Items"}, {Index: 2, HasIndex: true}, {Name: "Price"}}.
+type Path []Segment
+
+// Segment is one step of a Path: a field Name, an Index into a slice or array, or a Key into a map.
+// Only one of them is set, and HasIndex tells index segments apart, so that the zero Index of a
+// name or key segment is not read as index 0.
+type Segment struct {
+	Name     string
+	Index    int
+	HasIndex bool
+	Key      string
+}
+
+// Path returns the structured form of the field of the error. It is nil for errors without a field.
+// Errors of nested values carry the path they were built with; the fields of other errors, and of
+// errors whose Field was changed since, are parsed with ParsePath.
+func (ve *ValidationError) Path() Path {
+	if ve.path != nil && ve.pathField == ve.Field {
+		return ve.path
+	}
+	return ParsePath(ve.Field)
+}
+
+// fieldPrefix is the prefix of the fields of nested errors, e.g. "Lines[1].", and its Path.
+type fieldPrefix struct {
+	field string
+	path  Path
+}
+
+// nest returns the prefix of the fields of the value at name, e.g. "Lines[1].Product." for
+// "Product" in "Lines[1].", or of its element at index i if i is not -1, e.g. "Lines[1]." for
+// "Lines" and 1.
+func (p fieldPrefix) nest(name string, i int) fieldPrefix {
+	path := p.at(name)
+	if i < 0 {
+		return fieldPrefix{p.field + name + ".", path}
+	}
+	return fieldPrefix{p.field + name + "[" + strconv.Itoa(i) + "].", append(path, Segment{Index: i, HasIndex: true})}
+}
+
+// at returns the path of the field name within the prefix.
+func (p fieldPrefix) at(name string) Path {
+	// The path is clipped so that appends copy it instead of sharing it between errors.
+	path := p.path[:len(p.path):len(p.path)]
+	if name == "" {
+		return path
+	}
+	return append(path, Segment{Name: name})
+}
+
+// ParsePath parses a field in the dotted form used by the Validator, e.g. "Items[2].Price" or
+// "Labels[app]", where a bracketed number is an index and anything else a map key. Fields starting
+// with "/" are parsed as JSON Pointers, e.g. "/items/2/price", as reported by runtime schemas in
+// that mode; their numeric tokens are indexes.
+func ParsePath(field string) Path {
+	if field == "" {
+		return nil
+	}
+	if field[0] == '/' {
+		return parsePointer(field)
+	}
+	var p Path
+	for i := 0; i < len(field); {
+		switch field[i] {
+		case '.':
+			i++
+		case '[':
+			end := strings.IndexByte(field[i:], ']')
+			if end < 0 {
+				end = len(field) - i
+			}
+			p = append(p, bracketSegment(field[i+1:i+end]))
+			i += end + 1
+		default:
+			end := strings.IndexAny(field[i:], ".[")
+			if end < 0 {
+				end = len(field) - i
+			}
+			p = append(p, Segment{Name: field[i : i+end]})
+			i += end
+		}
+	}
+	return p
+}
+
+func bracketSegment(s string) Segment {
+	if n, ok := pathIndex(s); ok {
+		return Segment{Index: n, HasIndex: true}
+	}
+	return Segment{Key: s}
+}
+
+// pathIndex parses s as an index, which consists of decimal digits only.
+func pathIndex(s string) (int, bool) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+func parsePointer(field string) Path {
+	tokens := strings.Split(field[1:], "/")
+	p := make(Path, len(tokens))
+	for i, token := range tokens {
+		if n, ok := pathIndex(token); ok {
+			p[i] = Segment{Index: n, HasIndex: true}
+			continue
+		}
+		token = strings.ReplaceAll(token, "~1", "/")
+		p[i] = Segment{Name: strings.ReplaceAll(token, "~0", "~")}
+	}
+	return p
+}
+
+// String returns the dotted form of the path, e.g. "Items[2].Price".
+func (p Path) String() string {
+	var b strings.Builder
+	for _, s := range p {
+		switch {
+		case s.HasIndex:
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(s.Index))
+			b.WriteByte(']')
+		case s.Key != "":
+			b.WriteByte('[')
+			b.WriteString(s.Key)
+			b.WriteByte(']')
+		default:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(s.Name)
+		}
+	}
+	return b.String()
+}
+
+// JSONPointer returns the path as an RFC 6901 JSON Pointer, e.g. "/items/2/unit~1price" for
+// "items[2].unit/price", with "~" and "/" in names and keys escaped as "~0" and "~1". It can be
+// used for RFC 9457 problem details and clients addressing the invalid member. The empty path
+// is "", which points to the whole document.
+func (p Path) JSONPointer() string {
+	var b strings.Builder
+	for _, s := range p {
+		b.WriteByte('/')
+		switch {
+		case s.HasIndex:
+			b.WriteString(strconv.Itoa(s.Index))
+		case s.Key != "":
+			writePointerToken(&b, s.Key)
+		default:
+			writePointerToken(&b, s.Name)
+		}
+	}
+	return b.String()
+}
+
+func writePointerToken(b *strings.Builder, token string) {
+	for i := 0; i < len(token); i++ {
+		switch token[i] {
+		case '~':
+			b.WriteString("~0")
+		case '/':
+			b.WriteString("~1")
+		default:
+			b.WriteByte(token[i])
+		}
+	}
+}
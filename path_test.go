@@ -0,0 +1,119 @@
+package rapidval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	name := func(n string) Segment { return Segment{Name: n} }
+	key := func(k string) Segment { return Segment{Key: k} }
+	index := func(i int) Segment { return Segment{Index: i, HasIndex: true} }
+
+	tests := []struct {
+		field string
+		want  Path
+		str   string
+	}{
+		{"", nil, ""},
+		{"Email", Path{name("Email")}, "Email"},
+		{"Address.City", Path{name("Address"), name("City")}, "Address.City"},
+		{"Items[2].Price", Path{name("Items"), index(2), name("Price")}, "Items[2].Price"},
+		{"Matrix[0][1]", Path{name("Matrix"), index(0), index(1)}, "Matrix[0][1]"},
+		{"Labels[app.kubernetes.io/name]", Path{name("Labels"), key("app.kubernetes.io/name")}, "Labels[app.kubernetes.io/name]"},
+		{"Scores[-1]", Path{name("Scores"), key("-1")}, "Scores[-1]"},
+		{"[3].Name", Path{index(3), name("Name")}, "[3].Name"},
+		{"/items/2/price", Path{name("items"), index(2), name("price")}, "items[2].price"},
+		{"/a~1b/c~0d", Path{name("a/b"), name("c~d")}, "a/b.c~d"},
+	}
+
+	for _, tt := range tests {
+		got := ParsePath(tt.field)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePath(%q) = %+v, want %+v", tt.field, got, tt.want)
+		}
+		if s := got.String(); s != tt.str {
+			t.Errorf("ParsePath(%q).String() = %q, want %q", tt.field, s, tt.str)
+		}
+	}
+}
+
+func TestPathZeroSegments(t *testing.T) {
+	p := Path{{Name: "Items"}, {Index: 0, HasIndex: true}, {Name: "SKU"}}
+	if got := p.String(); got != "Items[0].SKU" {
+		t.Errorf("String() = %q, want %q", got, "Items[0].SKU")
+	}
+}
+
+func TestPathJSONPointer(t *testing.T) {
+	tests := []struct {
+		path Path
+		want string
+	}{
+		{nil, ""},
+		{ParsePath("Email"), "/Email"},
+		{ParsePath("items[2].unit/price"), "/items/2/unit~1price"},
+		{ParsePath("Labels[app.kubernetes.io/name]"), "/Labels/app.kubernetes.io~1name"},
+		{ParsePath("a~b[0][1]"), "/a~0b/0/1"},
+		{ParsePath("/a~1b/c~0d/3"), "/a~1b/c~0d/3"},
+		{Path{{Name: "items"}, {Index: 0, HasIndex: true}, {Key: "id"}}, "/items/0/id"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.path.JSONPointer(); got != tt.want {
+			t.Errorf("%v.JSONPointer() = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+type pathLine struct {
+	SKU string
+}
+
+func (l *pathLine) Validations() P {
+	return P{Required("SKU", l.SKU)}
+}
+
+type pathOrder struct {
+	Lines []*pathLine
+}
+
+func (o *pathOrder) Validations() P {
+	return P{EachNested("Lines", o.Lines)}
+}
+
+func TestValidationErrorPath(t *testing.T) {
+	errs, _ := New().Validate(&pathOrder{Lines: []*pathLine{{SKU: "A"}, {}}}).(ValidationErrors)
+	if len(errs) != 1 {
+		t.Fatalf("Validate() = %v, want 1 error", errs)
+	}
+	want := Path{{Name: "Lines"}, {Index: 1, HasIndex: true}, {Name: "SKU"}}
+	if got := errs[0].Path(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Path() = %+v, want %+v", got, want)
+	}
+}
+
+type pathLabels struct {
+	Line *pathLine
+}
+
+func (l *pathLabels) Validations() P {
+	return P{Nested("app.kubernetes.io/sku", l.Line)}
+}
+
+func TestValidationErrorPathBuilt(t *testing.T) {
+	errs, _ := New().Validate(&pathLabels{Line: &pathLine{}}).(ValidationErrors)
+	if len(errs) != 1 {
+		t.Fatalf("Validate() = %v, want 1 error", errs)
+	}
+	want := Path{{Name: "app.kubernetes.io/sku"}, {Name: "SKU"}}
+	if got := errs[0].Path(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Path() = %+v, want %+v", got, want)
+	}
+
+	errs[0].Field = "Items[0].SKU"
+	want = Path{{Name: "Items"}, {Index: 0, HasIndex: true}, {Name: "SKU"}}
+	if got := errs[0].Path(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Path() after changing Field = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,30 @@
+package rapidval
+
+import (
+	"regexp"
+	"sync"
+)
+
+// patterns caches the expressions compiled by Matches, keyed by pattern.
+var patterns sync.Map // map[string]*regexp.Regexp
+
+// Matches validates that value matches the regular expression pattern. Compiled patterns are cached,
+// so the same pattern is compiled only once however often the rule runs.
+// It panics if pattern does not compile, since that is a programming error.
+func Matches(field string, value string, pattern string) *ValidationError {
+	re, ok := patterns.Load(pattern)
+	if !ok {
+		re, _ = patterns.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	}
+	return MatchesRegexp(field, value, re.(*regexp.Regexp))
+}
+
+// MatchesRegexp validates that value matches re. The expression is available as the Pattern param.
+func MatchesRegexp(field string, value string, re *regexp.Regexp) *ValidationError {
+	if !re.MatchString(value) {
+		err := newError(field, MsgPattern, value)
+		err.MessageParams[Pattern] = re.String()
+		return err
+	}
+	return nil
+}
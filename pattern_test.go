@@ -0,0 +1,52 @@
+package rapidval
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestMatches(t *testing.T) {
+	const slug = `^[a-z0-9]+(-[a-z0-9]+)*$`
+
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"hello-world", false},
+		{"v2", false},
+		{"Hello", true},
+		{"hello--world", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		err := Matches("Slug", tt.value, slug)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Matches(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && (err.MessageKey != MsgPattern || err.MessageParams[Pattern] != slug) {
+			t.Errorf("Matches(%q) = %v %v", tt.value, err.MessageKey, err.MessageParams)
+		}
+	}
+
+	if _, ok := patterns.Load(slug); !ok {
+		t.Error("Matches() should cache the compiled pattern")
+	}
+
+	if err := MatchesRegexp("Code", "AB-12", regexp.MustCompile(`^[A-Z]{2}-\d{2}$`)); err != nil {
+		t.Errorf("MatchesRegexp() = %v, want nil", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Matches() should panic on an invalid pattern")
+		}
+	}()
+	Matches("Slug", "x", "(")
+}
+
+func BenchmarkMatches(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Matches("Slug", "hello-world", `^[a-z0-9]+(-[a-z0-9]+)*$`)
+	}
+}
@@ -0,0 +1,121 @@
+package rapidval
+
+import (
+	"strings"
+	"sync"
+)
+
+// PhoneRegion describes the phone numbers of a region for PhoneForRegion.
+type PhoneRegion struct {
+	// CallingCode is the country calling code without "+", e.g. "90".
+	CallingCode string
+	// TrunkPrefix is the prefix dialed before national numbers within the region, e.g. "0", if any.
+	TrunkPrefix string
+	// MinLength and MaxLength bound the number of digits of the national significant number,
+	// which excludes the calling code and the trunk prefix.
+	MinLength, MaxLength int
+}
+
+var phoneRegions = struct {
+	sync.RWMutex
+	regions map[string]PhoneRegion
+}{regions: map[string]PhoneRegion{
+	"TR": {CallingCode: "90", TrunkPrefix: "0", MinLength: 10, MaxLength: 10},
+	"US": {CallingCode: "1", TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	"CA": {CallingCode: "1", TrunkPrefix: "1", MinLength: 10, MaxLength: 10},
+	"GB": {CallingCode: "44", TrunkPrefix: "0", MinLength: 9, MaxLength: 10},
+	"DE": {CallingCode: "49", TrunkPrefix: "0", MinLength: 6, MaxLength: 13},
+	"FR": {CallingCode: "33", TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	"NL": {CallingCode: "31", TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	"ES": {CallingCode: "34", MinLength: 9, MaxLength: 9},
+	// IT: the leading 0 of landlines is part of the number, so there is no trunk prefix.
+	"IT": {CallingCode: "39", MinLength: 6, MaxLength: 11},
+	"AZ": {CallingCode: "994", TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	"RU": {CallingCode: "7", TrunkPrefix: "8", MinLength: 10, MaxLength: 10},
+	"IN": {CallingCode: "91", TrunkPrefix: "0", MinLength: 10, MaxLength: 10},
+	"JP": {CallingCode: "81", TrunkPrefix: "0", MinLength: 9, MaxLength: 10},
+	"AU": {CallingCode: "61", TrunkPrefix: "0", MinLength: 9, MaxLength: 9},
+	"BR": {CallingCode: "55", TrunkPrefix: "0", MinLength: 10, MaxLength: 11},
+}}
+
+// RegisterPhoneRegion adds or replaces the phone number rules of a region used by PhoneForRegion.
+// Regions are free-form keys such as ISO 3166 codes ("TR", "DE").
+func RegisterPhoneRegion(region string, r PhoneRegion) {
+	phoneRegions.Lock()
+	defer phoneRegions.Unlock()
+	phoneRegions.regions[region] = r
+}
+
+// Phone validates a phone number in E.164 format: "+" followed by the country calling code and
+// the subscriber number, 7 to 15 digits in total, without spaces or other separators, e.g. "+905321234567".
+func Phone(field string, value string) *ValidationError {
+	if !isE164(value) {
+		return newError(field, MsgInvalidPhone, value)
+	}
+	return nil
+}
+
+func isE164(value string) bool {
+	if len(value) < 8 || len(value) > 16 || value[0] != '+' || value[1] == '0' {
+		return false
+	}
+	for i := 1; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// PhoneForRegion validates a phone number of region, either in international format with the
+// calling code of the region ("+90 532 123 45 67") or in national format, with or without the trunk
+// prefix ("0532 123 45 67"); national numbers starting with the trunk prefix are read as dialed
+// with it. Spaces, dashes, dots and parentheses are allowed between digits.
+// Only the length of the national number is checked, not the number plan of the region.
+//
+// The most common regions are built in; others are added with RegisterPhoneRegion. Numbers of
+// regions without rules are reported as invalid.
+func PhoneForRegion(field string, value string, region string) *ValidationError {
+	phoneRegions.RLock()
+	r, ok := phoneRegions.regions[region]
+	phoneRegions.RUnlock()
+	if !ok || !r.valid(value) {
+		err := newError(field, MsgInvalidPhone, value)
+		err.MessageParams[Region] = region
+		return err
+	}
+	return nil
+}
+
+// valid reports whether value is a phone number of r.
+func (r PhoneRegion) valid(value string) bool {
+	var buf [20]byte
+	digits := buf[:0]
+	international := false
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case c >= '0' && c <= '9':
+			if len(digits) == len(buf) {
+				return false
+			}
+			digits = append(digits, c)
+		case c == '+' && i == 0:
+			international = true
+		case c == ' ' || c == '-' || c == '.' || c == '(' || c == ')':
+		default:
+			return false
+		}
+	}
+
+	number := string(digits)
+	switch {
+	case international:
+		var ok bool
+		if number, ok = strings.CutPrefix(number, r.CallingCode); !ok {
+			return false
+		}
+	case r.TrunkPrefix != "":
+		number = strings.TrimPrefix(number, r.TrunkPrefix)
+	}
+	return len(number) >= r.MinLength && len(number) <= r.MaxLength
+}
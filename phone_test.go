@@ -0,0 +1,81 @@
+package rapidval
+
+import "testing"
+
+func TestPhone(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"+905321234567", false},
+		{"+14155552671", false},
+		{"+2901234", false},
+		{"+123456789012345", false},
+		{"+1234567890123456", true},
+		{"+290123", true},
+		{"905321234567", true},
+		{"+0905321234567", true},
+		{"+90 532 123 45 67", true},
+		{"+90532123456a", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		err := Phone("Phone", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Phone(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgInvalidPhone {
+			t.Errorf("Phone(%q).MessageKey = %v, want %v", tt.value, err.MessageKey, MsgInvalidPhone)
+		}
+	}
+}
+
+func TestPhoneForRegion(t *testing.T) {
+	RegisterPhoneRegion("XK", PhoneRegion{CallingCode: "383", TrunkPrefix: "0", MinLength: 8, MaxLength: 9})
+
+	tests := []struct {
+		value   string
+		region  string
+		wantErr bool
+	}{
+		{"+905321234567", "TR", false},
+		{"+90 (532) 123-45-67", "TR", false},
+		{"0532 123 45 67", "TR", false},
+		{"532 123 45 67", "TR", false},
+		{"0532 123 45 6", "TR", true},
+		{"+49 30 123456", "TR", true},
+		{"+1 415 555 2671", "US", false},
+		{"1 415 555 2671", "US", false},
+		{"(415) 555-2671", "US", false},
+		{"415 555 267", "US", true},
+		{"+49 30 123456", "DE", false},
+		{"030 123456", "DE", false},
+		{"06 12345678", "IT", false},
+		{"+44 20 7946 0958", "GB", false},
+		{"8 912 345 67 89", "RU", false},
+		{"+383 44 123 456", "XK", false},
+		{"+90 532 123 45 67 ext", "TR", true},
+		{"90+5321234567", "TR", true},
+		{"+9053212345678901234567", "TR", true},
+		{"+905321234567", "ZZ", true},
+	}
+
+	for _, tt := range tests {
+		err := PhoneForRegion("Phone", tt.value, tt.region)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("PhoneForRegion(%q, %q) error = %v, wantErr %v", tt.value, tt.region, err, tt.wantErr)
+		}
+		if err != nil && (err.MessageKey != MsgInvalidPhone || err.MessageParams[Region] != tt.region) {
+			t.Errorf("PhoneForRegion(%q, %q) = %v %v", tt.value, tt.region, err.MessageKey, err.MessageParams)
+		}
+	}
+
+	tr := NewTranslator()
+	if got, want := tr.Translate(PhoneForRegion("Phone", "1", "TR")), "Phone geçerli bir TR telefon numarası olmalıdır"; got != want {
+		t.Errorf("Translate() = %q, want %q", got, want)
+	}
+	if got, want := tr.Translate(Phone("Phone", "1")), "Phone geçerli bir telefon numarası olmalıdır"; got != want {
+		t.Errorf("Translate() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,42 @@
+package rapidval
+
+import (
+	"regexp"
+	"sync"
+)
+
+var plates = struct {
+	sync.RWMutex
+	patterns map[string]*regexp.Regexp
+}{patterns: map[string]*regexp.Regexp{
+	// TR: province code 01-81, then 1 letter and 4 digits, 2 letters and 3-4 digits, or 3 letters and 2-3 digits.
+	"TR": regexp.MustCompile(`^(0[1-9]|[1-7][0-9]|8[01]) ?([A-Z] ?[0-9]{4}|[A-Z]{2} ?[0-9]{3,4}|[A-Z]{3} ?[0-9]{2,3})$`),
+	// DE: district code, 1-2 letters and 1-4 digits, optionally followed by E (electric) or H (historic).
+	"DE": regexp.MustCompile(`^[A-ZÄÖÜ]{1,3}[- ][A-Z]{1,2} ?[1-9][0-9]{0,3}[EH]?$`),
+}}
+
+// RegisterLicensePlate adds or replaces the license plate pattern of a region used by LicensePlate.
+// Regions are free-form keys such as ISO 3166 codes ("TR", "DE") or subdivisions ("US-CA").
+// The pattern must match the whole plate, so it should be anchored with ^ and $.
+// It panics if pattern does not compile.
+func RegisterLicensePlate(region string, pattern string) {
+	re := regexp.MustCompile(pattern)
+	plates.Lock()
+	defer plates.Unlock()
+	plates.patterns[region] = re
+}
+
+// LicensePlate validates a license plate against the pattern registered for region.
+// TR and DE are built in; other regions, such as US states, are added with RegisterLicensePlate.
+// Plates of regions without a pattern are reported as invalid.
+func LicensePlate(field string, value string, region string) *ValidationError {
+	plates.RLock()
+	re, ok := plates.patterns[region]
+	plates.RUnlock()
+	if !ok || !re.MatchString(value) {
+		err := newError(field, MsgInvalidLicensePlate, value)
+		err.MessageParams[Region] = region
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package rapidval
+
+import "testing"
+
+func TestLicensePlate(t *testing.T) {
+	RegisterLicensePlate("US-CA", `^[1-9][A-Z]{3}[0-9]{3}$`)
+
+	tests := []struct {
+		value   string
+		region  string
+		wantErr bool
+	}{
+		{"34 ABC 123", "TR", false},
+		{"34ABC12", "TR", false},
+		{"06 A 1234", "TR", false},
+		{"81 AB 123", "TR", false},
+		{"82 AB 123", "TR", true},
+		{"34 A 123", "TR", true},
+		{"34 ABCD 12", "TR", true},
+		{"B-MW 1234", "DE", false},
+		{"M AB 12E", "DE", false},
+		{"B-MW 0123", "DE", true},
+		{"7ABC123", "US-CA", false},
+		{"ABC1234", "US-CA", true},
+		{"7ABC123", "US-NY", true},
+	}
+
+	for _, tt := range tests {
+		err := LicensePlate("Plate", tt.value, tt.region)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("LicensePlate(%q, %q) error = %v, wantErr %v", tt.value, tt.region, err, tt.wantErr)
+		}
+		if err != nil && (err.MessageKey != MsgInvalidLicensePlate || err.MessageParams[Region] != tt.region) {
+			t.Errorf("LicensePlate(%q, %q) = %v %v", tt.value, tt.region, err.MessageKey, err.MessageParams)
+		}
+	}
+}
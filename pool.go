@@ -0,0 +1,64 @@
+//go:build rapidval_pool
+
+package rapidval
+
+import "sync"
+
+// errorPool recycles the ValidationError values created by the built-in rules, and errorsPool the
+// backing arrays of the ValidationErrors returned by Validate. Neither has a New function: they only
+// hand out what Release returned.
+var errorPool, errorsPool sync.Pool
+
+// newError returns a ValidationError, from the pool if Release returned one, with the Field and
+// Value params set. Rules add their own params to MessageParams before returning it.
+func newError(field, key string, value interface{}) *ValidationError {
+	err, _ := errorPool.Get().(*ValidationError)
+	if err == nil {
+		err = &ValidationError{MessageParams: make(map[string]interface{}, 4)}
+	}
+	err.pooled = true
+	err.Field = field
+	err.MessageKey = key
+	err.CurrentValue = value
+	err.MessageParams[Field] = field
+	err.MessageParams[Value] = value
+	return err
+}
+
+// acquireErrors returns an empty slice, with the backing array of released errors if there is one.
+func acquireErrors() ValidationErrors {
+	if errs, ok := errorsPool.Get().(*ValidationErrors); ok {
+		return *errs
+	}
+	return nil
+}
+
+// Release returns the errors created by the built-in rules, and the slice itself, to an internal pool
+// so that services validating large volumes of records put less pressure on the garbage collector.
+// The pool is only used by programs built with the rapidval_pool tag.
+//
+// Release is optional. After calling it, neither the slice nor any of its errors (including their
+// MessageParams) may be used again. Errors constructed by callers are never recycled, and an error
+// listed twice in the slice, e.g. by a rule listed twice in Validations, is recycled once. Releasing
+// an error again in a later call is a bug, like any other use after Release: by then the error may
+// have been handed out to another goroutine, which would lose it to the pool.
+func (ve ValidationErrors) Release() {
+	for i, err := range ve {
+		if err != nil {
+			err.release()
+		}
+		ve[i] = nil
+	}
+	errs := ve[:0]
+	errorsPool.Put(&errs)
+}
+
+func (ve *ValidationError) release() {
+	if !ve.pooled {
+		return
+	}
+	params := ve.MessageParams
+	clear(params)
+	*ve = ValidationError{MessageParams: params}
+	errorPool.Put(ve)
+}
@@ -0,0 +1,32 @@
+//go:build !rapidval_pool
+
+package rapidval
+
+// Without the rapidval_pool build tag, errors are allocated like any other value. Taking them from
+// a pool keeps newError from being inlined into the rules, which makes boxing a constant field
+// allocate, so pooling would cost every caller that does not call Release.
+
+// newError returns a ValidationError with the Field and Value params set.
+// Rules add their own params to MessageParams before returning it.
+func newError(field, key string, value interface{}) *ValidationError {
+	return &ValidationError{
+		Field:         field,
+		MessageKey:    key,
+		MessageParams: map[string]interface{}{Field: field, Value: value},
+		CurrentValue:  value,
+	}
+}
+
+func acquireErrors() ValidationErrors {
+	return nil
+}
+
+// Release returns the errors created by the built-in rules, and the slice itself, to an internal pool
+// so that services validating large volumes of records put less pressure on the garbage collector.
+// The pool is only used by programs built with the rapidval_pool tag; otherwise Release does nothing.
+//
+// Release is optional. After calling it, neither the slice nor any of its errors (including their
+// MessageParams) may be used again.
+func (ve ValidationErrors) Release() {}
+
+func (ve *ValidationError) release() {}
@@ -0,0 +1,81 @@
+//go:build rapidval_pool
+
+package rapidval
+
+import "testing"
+
+// repeatedRule lists its rule twice.
+type repeatedRule struct {
+	rule Rule
+}
+
+func (r *repeatedRule) Validations() P {
+	return P{r.rule, r.rule}
+}
+
+func TestRelease(t *testing.T) {
+	t.Run("recycles built-in errors", func(t *testing.T) {
+		err := MinLength("name", "J", 3)
+		errs := ValidationErrors{err}
+		errs.Release()
+
+		if err.Field != "" || err.MessageKey != "" || len(err.MessageParams) != 0 || err.CurrentValue != nil {
+			t.Errorf("released error was not reset: %+v", err)
+		}
+		if errs[0] != nil {
+			t.Error("Release() should clear the slice")
+		}
+	})
+
+	t.Run("keeps caller constructed errors", func(t *testing.T) {
+		params := map[string]interface{}{Field: "name"}
+		err := &ValidationError{Field: "name", MessageKey: MsgRequired, MessageParams: params}
+		ValidationErrors{err}.Release()
+
+		if err.Field != "name" || len(params) != 1 {
+			t.Errorf("caller constructed error was modified: %+v", err)
+		}
+	})
+
+	t.Run("error listed twice", func(t *testing.T) {
+		errs, _ := New().Validate(&repeatedRule{Required("name", "")}).(ValidationErrors)
+		if len(errs) != 2 || errs[0] != errs[1] {
+			t.Fatalf("Validate() = %v, want the same error twice", errs)
+		}
+		errs.Release()
+
+		a, b := Required("a", ""), Required("b", "")
+		if a == b {
+			t.Error("an error listed twice was handed out twice")
+		}
+	})
+
+	t.Run("validator errors", func(t *testing.T) {
+		err := New().Validate(&testStruct2{})
+		verr, ok := err.(ValidationErrors)
+		if !ok || len(verr) != 2 {
+			t.Fatalf("Validate() = %v, want 2 errors", err)
+		}
+		verr.Release()
+
+		if err := New().Validate(&testStruct2{}); len(err.(ValidationErrors)) != 2 {
+			t.Errorf("Validate() after Release() = %v, want 2 errors", err)
+		}
+	})
+}
+
+func BenchmarkRelease(b *testing.B) {
+	v := New()
+	b.Run("without Release", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			v.Validate(&testStruct3{Name: "J", Email: "invalid", Age: 10})
+		}
+	})
+	b.Run("with Release", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if errs, ok := v.Validate(&testStruct3{Name: "J", Email: "invalid", Age: 10}).(ValidationErrors); ok {
+				errs.Release()
+			}
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package rapidval
+
+import "strings"
+
+// WithKeyPrefix prepends prefix to the message keys of the built-in rules, and to those added with
+// RegisterMessageKey, in the errors returned by the Validator, e.g. "myapp." turns
+// "validation.required" into "myapp.validation.required". Other keys of custom rules are left as
+// they are. Use NewTranslatorWithPrefix for matching translations.
+func WithKeyPrefix(prefix string) Option {
+	return func(v *Validator) {
+		v.keyPrefix = prefix
+	}
+}
+
+// NewTranslatorWithPrefix creates a new Translator with the default messages of DefaultLocale,
+// including those added with RegisterMessageKey, under prefixed keys, matching the errors of a
+// Validator created with WithKeyPrefix.
+func NewTranslatorWithPrefix(prefix string) *Translator {
+	defaults := DefaultMessages(DefaultLocale)
+	messages := make(map[string]string, len(defaults))
+	for key, msg := range defaults {
+		messages[prefix+key] = msg
+	}
+	return NewTranslatorWithMessages(messages)
+}
+
+// prefixKeys applies the key prefix to the built-in and registered message keys in errs.
+func (v *Validator) prefixKeys(errs ValidationErrors) {
+	for _, err := range errs {
+		if strings.HasPrefix(err.MessageKey, v.keyPrefix) {
+			continue
+		}
+		if knownKey(err.MessageKey) {
+			err.MessageKey = v.keyPrefix + err.MessageKey
+		}
+	}
+}
+
+// knownKey reports whether key is used by a built-in rule or was added with RegisterMessageKey.
+func knownKey(key string) bool {
+	if _, builtin := defaultMessages[key]; builtin {
+		return true
+	}
+	registered.RLock()
+	defer registered.RUnlock()
+	_, ok := registered.messages[key]
+	return ok
+}
@@ -0,0 +1,44 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+)
+
+type prefixStruct struct {
+	Name string
+}
+
+func (p *prefixStruct) Validations() P {
+	return P{
+		Required("Name", p.Name),
+		RuleFunc(func(ctx context.Context) *ValidationError {
+			return &ValidationError{Field: "Name", MessageKey: "myapp.validation.unique"}
+		}),
+		&ValidationError{Field: "Name", MessageKey: "custom.key"},
+		&ValidationError{Field: "Name", MessageKey: msgRegistered, MessageParams: map[string]interface{}{Field: "Name"}},
+	}
+}
+
+func TestWithKeyPrefix(t *testing.T) {
+	v := New(WithKeyPrefix("myapp."))
+	errs, ok := v.Validate(&prefixStruct{}).(ValidationErrors)
+	if !ok || len(errs) != 4 {
+		t.Fatalf("Validate() = %v, want 4 errors", errs)
+	}
+
+	want := []string{"myapp.validation.required", "myapp.validation.unique", "custom.key", "myapp." + msgRegistered}
+	for i, key := range want {
+		if errs[i].MessageKey != key {
+			t.Errorf("errs[%d].MessageKey = %v, want %v", i, errs[i].MessageKey, key)
+		}
+	}
+
+	tr := NewTranslatorWithPrefix("myapp.")
+	if got, want := tr.Translate(errs[0]), "Name alanı zorunludur"; got != want {
+		t.Errorf("Translate() = %v, want %v", got, want)
+	}
+	if got, want := tr.Translate(errs[3]), "Name kayıtlı"; got != want {
+		t.Errorf("Translate() = %v, want %v", got, want)
+	}
+}
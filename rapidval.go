@@ -20,8 +20,13 @@
 package rapidval
 
 import (
+	"cmp"
+	"context"
+	"errors"
+	"reflect"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // Validateable is an interface that can be implemented by any struct to add custom validation logic.
@@ -33,6 +38,36 @@ type Validateable interface {
 	Validations() P
 }
 
+// ValidateableCtx is implemented by types whose rules need the context while P is built, e.g. to run a
+// uniqueness query that respects cancellation and deadlines. ValidateContext and nested validation calls
+// ValidationsContext instead of Validations with the context passed to ValidateContext. Validations is
+// still used by Validate, which has no context, and usually delegates:
+//
+//	func (u *User) Validations() rapidval.P { return u.ValidationsContext(context.Background()) }
+//
+//	func (u *User) ValidationsContext(ctx context.Context) rapidval.P {
+//	    taken, err := users.EmailTaken(ctx, u.Email)
+//	    return rapidval.P{
+//	        rapidval.Email("Email", u.Email),
+//	        rapidval.FromError("Email", err, rapidval.MsgUnavailable),
+//	        rapidval.When(taken, &rapidval.ValidationError{Field: "Email", MessageKey: "validation.unique"}),
+//	    }
+//	}
+//
+// Rules that only need the context when they run can instead be written as a RuleFunc in Validations.
+type ValidateableCtx interface {
+	Validateable
+	ValidationsContext(ctx context.Context) P
+}
+
+// validationsFunc returns the function building the rules of val, passing ctx to ValidateableCtx types.
+func validationsFunc(ctx context.Context, val Validateable) func() P {
+	if vc, ok := val.(ValidateableCtx); ok {
+		return func() P { return vc.ValidationsContext(ctx) }
+	}
+	return val.Validations
+}
+
 // ValidationError represents a single validation error.
 // It contains the field name, message key, and any parameters needed for translation.
 type ValidationError struct {
@@ -40,6 +75,21 @@ type ValidationError struct {
 	MessageKey    string
 	MessageParams map[string]interface{}
 	CurrentValue  interface{}
+
+	// Cause is the underlying error of a failed check, e.g. a DNS failure or a database timeout
+	// in a remote rule. It is meant for logs and is not part of the translated message.
+	Cause error
+
+	// Warning marks errors produced by rules wrapped in Warn. Warnings do not fail validation and
+	// are only reported through Result.Warnings.
+	Warning bool
+
+	// pooled is set while the error is owned by the caller and can be recycled by Release.
+	pooled bool
+
+	// path is the structured form of pathField, the Field of nested errors when they were built.
+	path      Path
+	pathField string
 }
 
 // Error implements the error interface.
@@ -48,6 +98,11 @@ func (ve *ValidationError) Error() string {
 	return ve.MessageKey
 }
 
+// Unwrap returns the Cause of the error, so errors.Is and errors.As can inspect it.
+func (ve *ValidationError) Unwrap() error {
+	return ve.Cause
+}
+
 // ValidationErrors represents a collection of validation errors.
 type ValidationErrors []*ValidationError
 
@@ -61,87 +116,362 @@ func (ve ValidationErrors) Error() string {
 	return strings.Join(errors, "; ")
 }
 
+// Unwrap returns the individual errors, so errors.Is and errors.As can inspect each of them and their causes.
+func (ve ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(ve))
+	for i, err := range ve {
+		errs[i] = err
+	}
+	return errs
+}
+
 // Validator handles the validation process and collects validation errors.
+// A Validator holds no per-call state and is safe for concurrent use.
 type Validator struct {
-	errors ValidationErrors
+	stats         *stats
+	metrics       *Counters
+	trace         func(TraceEvent)
+	keyPrefix     string
+	repanic       bool
+	failFast      bool
+	firstPerField bool
+	scoring       bool
+
+	timeout     time.Duration
+	timeoutMode FailureMode
 }
 
-// P (Params) is a collection of validation errors used for grouping validations.
-type P []*ValidationError
+// P (Params) is a collection of validation rules used for grouping validations.
+// The results of the built-in rules (*ValidationError) and lazily evaluated rules (RuleFunc) can be mixed freely.
+type P []Rule
 
 // Validate processes all validation rules and returns any validation errors.
 // If there are no errors, it returns nil.
 func (v *Validator) Validate(val Validateable) error {
-	params := val.Validations()
-	if len(params) == 0 {
-		return nil
+	return v.validate(context.Background(), val)
+}
+
+// ValidateContext is like Validate, but passes ctx to lazily evaluated rules and rule groups such as SkipUnless,
+// and to the ValidationsContext method of values implementing ValidateableCtx.
+func (v *Validator) ValidateContext(ctx context.Context, val Validateable) error {
+	return v.validate(ctx, val)
+}
+
+// ErrNilValue is returned by Validate when it is called with a nil pointer.
+var ErrNilValue = errors.New("rapidval: Validate called with a nil pointer")
+
+// Validate is a type-safe alternative to Validator.Validate for pointer types, e.g. rapidval.Validate(v, &user).
+// Instead of panicking inside Validations, a nil pointer is reported as ErrNilValue.
+// A nil v validates with the default settings.
+func Validate[T any, PT interface {
+	*T
+	Validateable
+}](v *Validator, val PT) error {
+	if val == nil {
+		return ErrNilValue
 	}
+	if v == nil {
+		v = &Validator{}
+	}
+	return v.validate(context.Background(), val)
+}
 
-	for _, err := range params {
-		if err != nil && err.MessageKey != "" {
-			v.errors = append(v.errors, err)
+func (v *Validator) validate(ctx context.Context, val Validateable) error {
+	if errs, _ := v.run(ctx, val); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// run validates val and returns its errors and warnings separately.
+func (v *Validator) run(ctx context.Context, val Validateable) (errs, warnings ValidationErrors) {
+	return v.runNamed(ctx, source{value: val, suffix: ".Validations"}, validationsFunc(ctx, val))
+}
+
+// runNamed is run for the validations function of a value, reported under src.
+func (v *Validator) runNamed(ctx context.Context, src source, validations func() P) (errs, warnings ValidationErrors) {
+	src = v.resolve(src)
+	if v.metrics != nil {
+		defer func() { v.metrics.record(src.name, errs) }()
+	}
+	if v.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.timeout)
+		defer cancel()
+	}
+	errs = v.collect(ctx, src, validations, fieldPrefix{}, nil)
+	if len(errs) == 0 {
+		return nil, nil
+	}
+	errs = v.result(errs)
+	n := 0
+	for _, err := range errs {
+		if err.Warning {
+			warnings = append(warnings, err)
+			continue
 		}
+		errs[n] = err
+		n++
 	}
+	clear(errs[n:])
+	if n == 0 {
+		errs.Release()
+		return nil, warnings
+	}
+	return errs[:n], warnings
+}
 
-	if len(v.errors) > 0 {
-		return v.errors
+// resolve formats the name of src if the Validator reports it in stats, traces or metrics.
+func (v *Validator) resolve(src source) source {
+	if v.stats != nil || v.trace != nil || v.metrics != nil {
+		return source{name: src.String()}
 	}
+	return src
+}
 
-	return nil
+// collect evaluates the rules returned by validations and appends their errors to errs,
+// with their fields prefixed by prefix. src identifies validations in stats and traces.
+func (v *Validator) collect(ctx context.Context, src source, validations func() P, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	if v.stopped(errs) {
+		return errs
+	}
+	src = v.resolve(src)
+	name := src.name
+	var start time.Time
+	if v.stats != nil {
+		start = time.Now()
+	}
+	params, perr := v.validations(src, validations)
+	if v.stats != nil {
+		elapsed := time.Since(start)
+		n := len(errs)
+		defer func() { v.stats.record(name, elapsed, len(errs) > n) }()
+	}
+	if perr != nil {
+		if sensitive(ctx) {
+			perr.redact()
+		}
+		if v.trace != nil {
+			v.trace(TraceEvent{Validations: name, Index: -1, Err: perr})
+		}
+		v.scoreRule(ctx, true)
+		errs = appendError(errs, prefix, perr)
+		return errs
+	}
+
+	errs = v.collectRules(ctx, name, params, prefix, errs)
+	return errs
+}
+
+// collectRules evaluates rules and appends their errors to errs, with their fields prefixed by prefix.
+func (v *Validator) collectRules(ctx context.Context, name string, rules []Rule, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	for i, rule := range rules {
+		if v.stopped(errs) {
+			break
+		}
+		if m, ok := rule.(multiRule); ok {
+			errs = m.collect(ctx, v, name, prefix, errs)
+			continue
+		}
+		if _, eager := rule.(*ValidationError); !eager && rule != nil && ctx.Err() != nil {
+			v.scoreRule(ctx, true)
+			errs = v.deadlineExceeded(ctx, errs)
+			continue
+		}
+		ve := v.check(ctx, rule)
+		if ve != nil && ve.MessageKey != "" && sensitive(ctx) {
+			ve.redact()
+		}
+		v.scoreRule(ctx, ve != nil && ve.MessageKey != "")
+		if v.trace != nil {
+			v.traceRule(name, i, rule, ve)
+		}
+		if ve != nil && ve.MessageKey != "" {
+			errs = appendError(errs, prefix, ve)
+		}
+	}
+	return errs
+}
+
+// appendError appends err to errs, prefixing its field with prefix.
+// Errors without a field are reported on the prefix itself, e.g. "Payment".
+func appendError(errs ValidationErrors, prefix fieldPrefix, err *ValidationError) ValidationErrors {
+	if errs == nil {
+		errs = acquireErrors()
+	}
+	if prefix.field != "" {
+		if err.Field == "" {
+			err.Field = strings.TrimSuffix(prefix.field, ".")
+			err.path = prefix.path
+		} else {
+			err.path = append(prefix.at(""), ParsePath(err.Field)...)
+			err.Field = prefix.field + err.Field
+		}
+		err.pathField = err.Field
+		if err.MessageParams == nil {
+			err.MessageParams = make(map[string]interface{}, 1)
+		}
+		err.MessageParams[Field] = err.Field
+		if other, ok := err.MessageParams[OtherField].(string); ok {
+			err.MessageParams[OtherField] = prefix.field + other
+		}
+	}
+	return append(errs, err)
+}
+
+// result applies the Validator's output settings to a non-empty list of errors.
+func (v *Validator) result(errs ValidationErrors) ValidationErrors {
+	if v.keyPrefix != "" {
+		v.prefixKeys(errs)
+	}
+	if v.firstPerField {
+		errs = firstPerField(errs)
+	}
+	return errs
+}
+
+// check evaluates a single rule.
+// A panicking rule is reported as a MsgInternal error unless the Validator was created with WithRepanic.
+func (v *Validator) check(ctx context.Context, rule Rule) (err *ValidationError) {
+	if err, ok := rule.(*ValidationError); ok {
+		return err
+	}
+	if rule == nil {
+		return nil
+	}
+	if !v.repanic {
+		defer func() {
+			if r := recover(); r != nil {
+				err = internalError(ruleName(rule), r)
+			}
+		}()
+	}
+	if v.stats == nil {
+		return rule.Check(ctx)
+	}
+
+	start := time.Now()
+	err = rule.Check(ctx)
+	v.stats.record(ruleName(rule), time.Since(start), err != nil)
+	return err
 }
 
 // Message Keys
 const (
-	MsgRequired        = "validation.required"
-	MsgInvalidEmail    = "validation.email"
-	MsgMinLength       = "validation.min_length"
-	MsgMaxLength       = "validation.max_length"
-	MsgBetween         = "validation.between"
-	MsgDateGreaterThan = "validation.date_greater_than"
-	MsgDateLessThan    = "validation.date_less_than"
+	MsgRequired             = "validation.required"
+	MsgInvalidEmail         = "validation.email"
+	MsgMinLength            = "validation.min_length"
+	MsgMaxLength            = "validation.max_length"
+	MsgBetween              = "validation.between"
+	MsgDateGreaterThan      = "validation.date_greater_than"
+	MsgDateLessThan         = "validation.date_less_than"
+	MsgMin                  = "validation.min"
+	MsgMax                  = "validation.max"
+	MsgOneOf                = "validation.one_of"
+	MsgInvalidType          = "validation.type"
+	MsgInvalidJSON          = "validation.json"
+	MsgMaxCount             = "validation.max_count"
+	MsgMinItems             = "validation.min_items"
+	MsgMaxItems             = "validation.max_items"
+	MsgInternal             = "validation.internal"
+	MsgNotBlank             = "validation.not_blank"
+	MsgUnavailable          = "validation.unavailable"
+	MsgTimeout              = "validation.timeout"
+	MsgContainsAny          = "validation.contains_any"
+	MsgContainsAll          = "validation.contains_all"
+	MsgLeadingTrailingSpace = "validation.leading_trailing_space"
+	MsgSingleLine           = "validation.single_line"
+	MsgMaxRepeats           = "validation.max_consecutive_repeats"
+	MsgInvalidCardExpiry    = "validation.invalid_card_expiry"
+	MsgCardExpired          = "validation.card_expired"
+	MsgInvalidCVC           = "validation.invalid_cvc"
+	MsgInvalidGTIN          = "validation.invalid_gtin"
+	MsgInvalidISBN10        = "validation.invalid_isbn10"
+	MsgInvalidISBN13        = "validation.invalid_isbn13"
+	MsgInvalidISSN          = "validation.invalid_issn"
+	MsgInvalidVIN           = "validation.invalid_vin"
+	MsgInvalidLicensePlate  = "validation.invalid_license_plate"
+	MsgOutsideArea          = "validation.outside_area"
+	MsgInvalidColor         = "validation.invalid_color"
+	MsgContrastRatio        = "validation.contrast_ratio"
+	MsgInvalidDigest        = "validation.invalid_digest"
+	MsgInvalidETag          = "validation.invalid_etag"
+	MsgInvalidPasswordHash  = "validation.invalid_password_hash"
+	MsgInvalidBucketName    = "validation.invalid_bucket_name"
+	MsgInvalidObjectKey     = "validation.invalid_object_key"
+	MsgInvalidDNSLabel      = "validation.invalid_dns_label"
+	MsgInvalidDNSSubdomain  = "validation.invalid_dns_subdomain"
+	MsgInvalidLabelValue    = "validation.invalid_label_value"
+	MsgInvalidHTTPMethod    = "validation.invalid_http_method"
+	MsgInvalidHTTPStatus    = "validation.invalid_http_status"
+	MsgInvalidMIMEType      = "validation.invalid_mime_type"
+	MsgInvalidLanguageTag   = "validation.invalid_language_tag"
+	MsgInvalidUsername      = "validation.invalid_username"
+	MsgReservedUsername     = "validation.reserved_username"
+	MsgInvalidNumericCode   = "validation.invalid_numeric_code"
+	MsgAmountBetween        = "validation.amount_between"
+	MsgCurrencyMismatch     = "validation.currency_mismatch"
+	MsgInvalidTaxRate       = "validation.invalid_tax_rate"
+	MsgInvalidSort          = "validation.invalid_sort"
+	MsgInvalidFilter        = "validation.invalid_filter"
+	MsgInvalidFieldMask     = "validation.invalid_field_mask"
+	MsgMaxDepth             = "validation.max_depth"
+	MsgMaxElements          = "validation.max_elements"
+	MsgMaxStringBytes       = "validation.max_string_bytes"
+	MsgSumAtMost            = "validation.sum_at_most"
+	MsgAscending            = "validation.ascending"
+	MsgOverlap              = "validation.overlap"
+	MsgRangeWithin          = "validation.range_within"
+	MsgPattern              = "validation.pattern"
+	MsgInvalidChecksum      = "validation.invalid_checksum"
+	MsgInvalidURL           = "validation.invalid_url"
+	MsgForbidden            = "validation.forbidden"
+	MsgNotFound             = "validation.not_found"
+	MsgInvalidUUID          = "validation.invalid_uuid"
+	MsgEqualField           = "validation.equal_field"
+	MsgGreaterThanField     = "validation.greater_than_field"
+	MsgDateAfterField       = "validation.date_after_field"
+	MsgInvalidPhone         = "validation.invalid_phone"
+	MsgNumeric              = "validation.numeric"
+	MsgAlpha                = "validation.alpha"
+	MsgAlphanumeric         = "validation.alphanumeric"
 )
 
 // MessageParam keys
 const (
-	Field = "Field"
-	Min   = "Min"
-	Max   = "Max"
-	Value = "Value"
+	Field        = "Field"
+	Min          = "Min"
+	Max          = "Max"
+	Value        = "Value"
+	Allowed      = "Allowed"
+	Type         = "Type"
+	RuleName     = "Rule"
+	Missing      = "Missing"
+	Region       = "Region"
+	Length       = "Length"
+	Jurisdiction = "Jurisdiction"
+	Token        = "Token"
+	Pattern      = "Pattern"
+	OtherField   = "OtherField"
 )
 
 // Required checks if a value is not zero according to its type.
 // For strings, it checks if the string is not empty.
-// For numbers, it checks if the number is not zero.
+// For numbers of any width, including floats, unsigned and complex numbers, it checks if the number is not zero.
 // For time.Time, it checks if the time is not zero.
-// For pointers and interfaces, it checks if the value is not nil.
+// For pointers, interfaces, maps, slices, channels and functions, it checks if the value is not nil.
 func Required(field string, value interface{}) *ValidationError {
 	if isZero(value) {
-		return &ValidationError{
-			Field:      field,
-			MessageKey: MsgRequired,
-			MessageParams: map[string]interface{}{
-				Field: field,
-				Value: value,
-			},
-			CurrentValue: value,
-		}
+		return newError(field, MsgRequired, value)
 	}
 	return nil
 }
 
-// Email validates if a string is a valid email address.
-// Currently checks for @ and . characters.
-func Email(field string, value string) *ValidationError {
-	if !strings.Contains(value, "@") || !strings.Contains(value, ".") {
-		return &ValidationError{
-			Field:      field,
-			MessageKey: MsgInvalidEmail,
-			MessageParams: map[string]interface{}{
-				Field: field,
-				Value: value,
-			},
-			CurrentValue: value,
-		}
+// RequiredNotBlank checks if a string contains at least one non-whitespace character.
+// Unlike Required, it also rejects strings such as "   ", and it reports them with MsgNotBlank.
+func RequiredNotBlank(field string, value string) *ValidationError {
+	if strings.TrimSpace(value) == "" {
+		return newError(field, MsgNotBlank, value)
 	}
 	return nil
 }
@@ -149,16 +479,9 @@ func Email(field string, value string) *ValidationError {
 // MinLength validates if a string's length is at least the specified minimum.
 func MinLength(field string, value string, min int) *ValidationError {
 	if len(value) < min {
-		return &ValidationError{
-			Field:      field,
-			MessageKey: MsgMinLength,
-			MessageParams: map[string]interface{}{
-				Field: field,
-				Min:   min,
-				Value: value,
-			},
-			CurrentValue: value,
-		}
+		err := newError(field, MsgMinLength, value)
+		err.MessageParams[Min] = min
+		return err
 	}
 	return nil
 }
@@ -166,51 +489,77 @@ func MinLength(field string, value string, min int) *ValidationError {
 // MaxLength validates if a string's length is at most the specified maximum.
 func MaxLength(field string, value string, max int) *ValidationError {
 	if len(value) > max {
-		return &ValidationError{
-			Field:      field,
-			MessageKey: MsgMaxLength,
-			MessageParams: map[string]interface{}{
-				Field: field,
-				Max:   max,
-				Value: value,
-			},
-			CurrentValue: value,
-		}
+		err := newError(field, MsgMaxLength, value)
+		err.MessageParams[Max] = max
+		return err
+	}
+	return nil
+}
+
+// MinRunes is like MinLength but counts characters (runes) instead of bytes, so "Çağ" has a length of 3.
+// It reports MsgMinLength, like MinLength.
+func MinRunes(field string, value string, min int) *ValidationError {
+	if utf8.RuneCountInString(value) < min {
+		err := newError(field, MsgMinLength, value)
+		err.MessageParams[Min] = min
+		return err
 	}
 	return nil
 }
 
-// Between validates if a number is between the specified minimum and maximum values (inclusive).
-func Between(field string, value int, min, max int) *ValidationError {
+// MaxRunes is like MaxLength but counts characters (runes) instead of bytes.
+// It reports MsgMaxLength, like MaxLength.
+func MaxRunes(field string, value string, max int) *ValidationError {
+	if utf8.RuneCountInString(value) > max {
+		err := newError(field, MsgMaxLength, value)
+		err.MessageParams[Max] = max
+		return err
+	}
+	return nil
+}
+
+// Between validates if a value is between the specified minimum and maximum values (inclusive).
+// It accepts any ordered type, so float64 prices, int64 IDs and uint quantities are checked without conversions:
+//
+//	rapidval.Between("Price", p.Price, 0.01, 999.99)
+func Between[T cmp.Ordered](field string, value T, min, max T) *ValidationError {
 	if value < min || value > max {
-		return &ValidationError{
-			Field:      field,
-			MessageKey: MsgBetween,
-			MessageParams: map[string]interface{}{
-				Field: field,
-				Min:   min,
-				Max:   max,
-				Value: value,
-			},
-			CurrentValue: value,
-		}
+		err := newError(field, MsgBetween, value)
+		err.MessageParams[Min] = min
+		err.MessageParams[Max] = max
+		return err
 	}
 	return nil
 }
 
+// OneOf validates that value is one of the allowed values, e.g. an enumeration received as a string:
+//
+//	rapidval.OneOf("Role", u.Role, "admin", "editor", "viewer")
+//
+// The allowed values are available to messages as Allowed, so they can be listed in the error.
+func OneOf(field string, value string, allowed ...string) *ValidationError {
+	return In(field, value, allowed...)
+}
+
+// In is like OneOf for any comparable type, e.g. typed enumeration constants or numeric codes.
+func In[T comparable](field string, value T, allowed ...T) *ValidationError {
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	err := newError(field, MsgOneOf, value)
+	// Copied so that the variadic arguments do not escape on the passing path.
+	err.MessageParams[Allowed] = append([]T(nil), allowed...)
+	return err
+}
+
 // DateGreaterThan validates if a time.Time is after the specified minimum time.
 func DateGreaterThan(field string, value, min time.Time) *ValidationError {
 	if value.Before(min) {
-		return &ValidationError{
-			Field:      field,
-			MessageKey: MsgDateGreaterThan,
-			MessageParams: map[string]interface{}{
-				Field: field,
-				Min:   min,
-				Value: value,
-			},
-			CurrentValue: value,
-		}
+		err := newError(field, MsgDateGreaterThan, value)
+		err.MessageParams[Min] = min
+		return err
 	}
 	return nil
 }
@@ -218,20 +567,27 @@ func DateGreaterThan(field string, value, min time.Time) *ValidationError {
 // DateLessThan validates if a time.Time is before the specified maximum time.
 func DateLessThan(field string, value, max time.Time) *ValidationError {
 	if value.After(max) {
-		return &ValidationError{
-			Field:      field,
-			MessageKey: MsgDateLessThan,
-			MessageParams: map[string]interface{}{
-				Field: field,
-				Max:   max,
-				Value: value,
-			},
-			CurrentValue: value,
-		}
+		err := newError(field, MsgDateLessThan, value)
+		err.MessageParams[Max] = max
+		return err
 	}
 	return nil
 }
 
+// FromError converts an error from parsing or a downstream service into a validation error for field,
+// reported under key and keeping err as its Cause. It returns nil if err is nil, so it can be used
+// directly on the result of a call:
+//
+//	rapidval.FromError("BirthDate", parseErr, rapidval.MsgInvalidType)
+func FromError(field string, err error, key string) *ValidationError {
+	if err == nil {
+		return nil
+	}
+	ve := newError(field, key, nil)
+	ve.Cause = err
+	return ve
+}
+
 // isZero checks if a value is the zero value for its type.
 // This is used internally by the Required validation.
 func isZero(v interface{}) bool {
@@ -240,6 +596,34 @@ func isZero(v interface{}) bool {
 		return v == ""
 	case int:
 		return v == 0
+	case int8:
+		return v == 0
+	case int16:
+		return v == 0
+	case int32:
+		return v == 0
+	case int64:
+		return v == 0
+	case uint:
+		return v == 0
+	case uint8:
+		return v == 0
+	case uint16:
+		return v == 0
+	case uint32:
+		return v == 0
+	case uint64:
+		return v == 0
+	case uintptr:
+		return v == 0
+	case float32:
+		return v == 0
+	case float64:
+		return v == 0
+	case complex64:
+		return v == 0
+	case complex128:
+		return v == 0
 	case bool:
 		return !v
 	case time.Time:
@@ -247,10 +631,21 @@ func isZero(v interface{}) bool {
 	case nil:
 		return true
 	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	}
 	return false
 }
 
-// New returns a new Validator.
-func New() *Validator {
-	return &Validator{}
+// Option configures a Validator.
+type Option func(*Validator)
+
+// New returns a new Validator configured with the given options.
+func New(opts ...Option) *Validator {
+	v := &Validator{}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
 }
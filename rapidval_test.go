@@ -1,6 +1,11 @@
 package rapidval
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -96,6 +101,34 @@ func TestRequired(t *testing.T) {
 	}
 }
 
+func TestRequiredNotBlank(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"empty", "", true},
+		{"spaces", "   ", true},
+		{"tabs and newlines", "\t\n", true},
+		{"unicode space", "\u00a0\u3000", true},
+		{"text", "John", false},
+		{"padded text", "  John  ", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RequiredNotBlank("name", tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RequiredNotBlank() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && err.MessageKey != MsgNotBlank {
+				t.Errorf("RequiredNotBlank() message key = %v, want %v", err.MessageKey, MsgNotBlank)
+			}
+		})
+	}
+}
+
 func TestEmail(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -316,6 +349,72 @@ func TestBetween(t *testing.T) {
 	}
 }
 
+func TestBetweenTypes(t *testing.T) {
+	if err := Between("Price", 19.99, 0.01, 999.99); err != nil {
+		t.Errorf("Between(float64) = %v, want nil", err)
+	}
+	err := Between("Price", 0.001, 0.01, 999.99)
+	if err == nil || err.MessageParams[Min] != 0.01 || err.CurrentValue != 0.001 {
+		t.Errorf("Between(float64) = %+v, want an error with float params", err)
+	}
+	const big = int64(1) << 62
+	if err := Between("ID", big+1, big, big+2); err != nil {
+		t.Errorf("Between(int64) = %v, want nil", err)
+	}
+	if err := Between("ID", big-1, big, big+2); err == nil {
+		t.Error("Between(int64) should fail without losing precision")
+	}
+	if err := Between("Quantity", uint(0), 1, 10); err == nil || err.MessageParams[Max] != uint(10) {
+		t.Errorf("Between(uint) = %+v, want an error with uint params", err)
+	}
+	if err := Between("Grade", "B", "A", "C"); err != nil {
+		t.Errorf("Between(string) = %v, want nil", err)
+	}
+}
+
+func TestOneOf(t *testing.T) {
+	type status int
+	const (
+		active status = iota + 1
+		suspended
+	)
+
+	tests := []struct {
+		name        string
+		err         *ValidationError
+		wantErr     bool
+		wantAllowed interface{}
+	}{
+		{"allowed", OneOf("Role", "admin", "admin", "user"), false, nil},
+		{"not allowed", OneOf("Role", "root", "admin", "user"), true, []string{"admin", "user"}},
+		{"case sensitive", OneOf("Role", "Admin", "admin", "user"), true, []string{"admin", "user"}},
+		{"no allowed values", OneOf("Role", "admin"), true, []string(nil)},
+		{"in ints", In("Level", 3, 1, 2, 3), false, nil},
+		{"not in ints", In("Level", 4, 1, 2, 3), true, []int{1, 2, 3}},
+		{"typed constants", In("Status", status(0), active, suspended), true, []status{active, suspended}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", tt.err, tt.wantErr)
+			}
+			if tt.err == nil {
+				return
+			}
+			if tt.err.MessageKey != MsgOneOf {
+				t.Errorf("MessageKey = %v, want %v", tt.err.MessageKey, MsgOneOf)
+			}
+			if got := tt.err.MessageParams[Allowed]; !reflect.DeepEqual(got, tt.wantAllowed) {
+				t.Errorf("Allowed = %#v, want %#v", got, tt.wantAllowed)
+			}
+		})
+	}
+
+	if got, want := NewTranslator().Translate(OneOf("Role", "root", "admin", "user")), "Role şu değerlerden biri olmalıdır: [admin user]"; got != want {
+		t.Errorf("Translate() = %q, want %q", got, want)
+	}
+}
+
 func TestDateValidations(t *testing.T) {
 	now := time.Now()
 	past := now.Add(-24 * time.Hour)
@@ -423,6 +522,45 @@ func TestValidationErrors(t *testing.T) {
 	}
 }
 
+func TestValidationErrorCause(t *testing.T) {
+	cause := errors.New("dial tcp: i/o timeout")
+	lookup := &ValidationError{Field: "Email", MessageKey: "validation.unique", Cause: fmt.Errorf("lookup: %w", cause)}
+
+	if lookup.Error() != "validation.unique" {
+		t.Errorf("Error() = %v, want the message key only", lookup.Error())
+	}
+	if !errors.Is(lookup, cause) {
+		t.Error("errors.Is() should find the cause of a ValidationError")
+	}
+
+	var err error = ValidationErrors{&ValidationError{Field: "Name", MessageKey: MsgRequired}, lookup}
+	if !errors.Is(err, cause) {
+		t.Error("errors.Is() should find the cause inside ValidationErrors")
+	}
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.Field != "Name" {
+		t.Errorf("errors.As() = %v, want the first ValidationError", ve)
+	}
+}
+
+func TestFromError(t *testing.T) {
+	if err := FromError("Age", nil, MsgInvalidType); err != nil {
+		t.Errorf("FromError() with nil error = %v, want nil", err)
+	}
+
+	_, parseErr := strconv.Atoi("abc")
+	err := FromError("Age", parseErr, MsgInvalidType)
+	if err == nil {
+		t.Fatal("FromError() returned nil")
+	}
+	if err.Field != "Age" || err.MessageKey != MsgInvalidType || err.MessageParams[Field] != "Age" {
+		t.Errorf("FromError() = %+v", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Error("FromError() should keep err as the cause")
+	}
+}
+
 func TestValidator(t *testing.T) {
 	v := &Validator{}
 
@@ -455,6 +593,22 @@ func TestValidator(t *testing.T) {
 	})
 }
 
+func TestGenericValidate(t *testing.T) {
+	if err := Validate(New(), &testStruct3{Name: "John", Email: "john@example.com", Age: 30}); err != nil {
+		t.Errorf("Validate() returned error: %v", err)
+	}
+
+	verr, ok := Validate(nil, &testStruct3{}).(ValidationErrors)
+	if !ok || len(verr) != 3 {
+		t.Errorf("Validate() = %v, want 3 errors", verr)
+	}
+
+	var nilStruct *testStruct3
+	if err := Validate(New(), nilStruct); !errors.Is(err, ErrNilValue) {
+		t.Errorf("Validate() with nil pointer = %v, want ErrNilValue", err)
+	}
+}
+
 func TestIsZero(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -481,6 +635,26 @@ func TestIsZero(t *testing.T) {
 			value: 42,
 			want:  false,
 		},
+		{name: "zero int8", value: int8(0), want: true},
+		{name: "zero int16", value: int16(0), want: true},
+		{name: "zero int32", value: int32(0), want: true},
+		{name: "zero int64", value: int64(0), want: true},
+		{name: "non-zero int64", value: int64(-1), want: false},
+		{name: "zero uint", value: uint(0), want: true},
+		{name: "zero uint8", value: uint8(0), want: true},
+		{name: "zero uint16", value: uint16(0), want: true},
+		{name: "zero uint32", value: uint32(0), want: true},
+		{name: "zero uint64", value: uint64(0), want: true},
+		{name: "non-zero uint64", value: uint64(7), want: false},
+		{name: "zero uintptr", value: uintptr(0), want: true},
+		{name: "zero float32", value: float32(0), want: true},
+		{name: "zero float64", value: float64(0), want: true},
+		{name: "negative zero float64", value: math.Copysign(0, -1), want: true},
+		{name: "non-zero float64", value: 0.01, want: false},
+		{name: "NaN", value: math.NaN(), want: false},
+		{name: "zero complex64", value: complex64(0), want: true},
+		{name: "zero complex128", value: complex128(0), want: true},
+		{name: "non-zero complex128", value: complex(0, 1), want: false},
 		{
 			name:  "false bool",
 			value: false,
@@ -511,6 +685,11 @@ func TestIsZero(t *testing.T) {
 			value: []string{},
 			want:  false,
 		},
+		{name: "nil pointer", value: (*testStruct)(nil), want: true},
+		{name: "non-nil pointer", value: &testStruct{}, want: false},
+		{name: "nil slice", value: []string(nil), want: true},
+		{name: "nil map", value: map[string]int(nil), want: true},
+		{name: "empty map", value: map[string]int{}, want: false},
 	}
 
 	for _, tt := range tests {
@@ -650,3 +829,12 @@ func BenchmarkIsZero(b *testing.B) {
 		})
 	}
 }
+
+func TestValidatorIsStateless(t *testing.T) {
+	v := New()
+	v.Validate(&testStruct2{})
+	verr, _ := v.Validate(&testStruct2{}).(ValidationErrors)
+	if len(verr) != 2 {
+		t.Errorf("second Validate() returned %d errors, want 2", len(verr))
+	}
+}
@@ -0,0 +1,68 @@
+// Package rapidvalent runs rapidval validation in ent hooks, enforcing rules at the persistence boundary.
+//
+// ent mutations do not carry an entity value, so a build function turns the mutation into a
+// rapidval.Validateable, typically the schema's own input type filled from the mutation fields:
+//
+//	func (User) Mixin() []ent.Mixin {
+//	    return []ent.Mixin{
+//	        rapidvalent.Mixin{Build: func(m ent.Mutation) rapidval.Validateable {
+//	            name, _ := m.Field("name")
+//	            email, _ := m.Field("email")
+//	            return &UserInput{Name: name.(string), Email: email.(string)}
+//	        }},
+//	    }
+//	}
+//
+// Invalid mutations are aborted with rapidval.ValidationErrors before reaching the database.
+// Values are validated under the context of the mutation, with Mixin.Validator if it is set.
+package rapidvalent
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/mixin"
+	"github.com/9ssi7/rapidval"
+)
+
+// Ops are the operations validated by Hook and Mixin.
+const Ops = ent.OpCreate | ent.OpUpdate | ent.OpUpdateOne
+
+// Hook returns an ent hook that validates create and update mutations.
+// build converts a mutation into the value to validate; returning nil skips validation.
+func Hook(build func(ent.Mutation) rapidval.Validateable) ent.Hook {
+	return HookWithValidator(rapidval.New(), build)
+}
+
+// HookWithValidator is like Hook but validates with v, e.g. one created with rapidval.WithTimeout.
+func HookWithValidator(v *rapidval.Validator, build func(ent.Mutation) rapidval.Validateable) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !m.Op().Is(Ops) {
+				return next.Mutate(ctx, m)
+			}
+			if val := build(m); val != nil {
+				if err := v.ValidateContext(ctx, val); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// Mixin is an ent schema mixin installing Hook with its Build function.
+type Mixin struct {
+	mixin.Schema
+	Build func(ent.Mutation) rapidval.Validateable
+	// Validator validates the built values. It defaults to rapidval.New().
+	Validator *rapidval.Validator
+}
+
+// Hooks implements ent.Mixin.
+func (m Mixin) Hooks() []ent.Hook {
+	if m.Validator == nil {
+		return []ent.Hook{Hook(m.Build)}
+	}
+	return []ent.Hook{HookWithValidator(m.Validator, m.Build)}
+}
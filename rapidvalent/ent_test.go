@@ -0,0 +1,118 @@
+package rapidvalent
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent"
+	"github.com/9ssi7/rapidval"
+)
+
+type userInput struct {
+	Name string
+}
+
+func (u *userInput) Validations() rapidval.P {
+	return rapidval.P{rapidval.Required("name", u.Name)}
+}
+
+// fakeMutation implements the parts of ent.Mutation used by the hook.
+type fakeMutation struct {
+	ent.Mutation
+	op     ent.Op
+	fields map[string]ent.Value
+}
+
+func (m *fakeMutation) Op() ent.Op { return m.op }
+
+func (m *fakeMutation) Field(name string) (ent.Value, bool) {
+	v, ok := m.fields[name]
+	return v, ok
+}
+
+func TestHook(t *testing.T) {
+	build := func(m ent.Mutation) rapidval.Validateable {
+		name, _ := m.Field("name")
+		s, _ := name.(string)
+		return &userInput{Name: s}
+	}
+
+	mutated := false
+	mutator := Mixin{Build: build}.Hooks()[0](ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		mutated = true
+		return nil, nil
+	}))
+
+	tests := []struct {
+		name        string
+		m           *fakeMutation
+		wantErr     bool
+		wantMutated bool
+	}{
+		{"valid create", &fakeMutation{op: ent.OpCreate, fields: map[string]ent.Value{"name": "John"}}, false, true},
+		{"invalid create", &fakeMutation{op: ent.OpCreate}, true, false},
+		{"invalid update", &fakeMutation{op: ent.OpUpdateOne}, true, false},
+		{"delete is not validated", &fakeMutation{op: ent.OpDelete}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutated = false
+			_, err := mutator.Mutate(context.Background(), tt.m)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Mutate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, ok := err.(rapidval.ValidationErrors); tt.wantErr && !ok {
+				t.Errorf("Mutate() error = %T, want ValidationErrors", err)
+			}
+			if mutated != tt.wantMutated {
+				t.Errorf("mutated = %v, want %v", mutated, tt.wantMutated)
+			}
+		})
+	}
+}
+
+func TestMixinValidator(t *testing.T) {
+	build := func(m ent.Mutation) rapidval.Validateable { return &userInput{} }
+	v := rapidval.New(rapidval.WithKeyPrefix("users."))
+	mutator := Mixin{Build: build, Validator: v}.Hooks()[0](ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		return nil, nil
+	}))
+
+	_, err := mutator.Mutate(context.Background(), &fakeMutation{op: ent.OpCreate})
+	verr, ok := err.(rapidval.ValidationErrors)
+	if !ok || len(verr) != 1 || verr[0].MessageKey != "users."+rapidval.MsgRequired {
+		t.Errorf("Mutate() error = %v, want users.%s", err, rapidval.MsgRequired)
+	}
+}
+
+type takenKey struct{}
+
+type handleInput struct {
+	Handle string
+}
+
+func (h *handleInput) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.RuleFunc(func(ctx context.Context) *rapidval.ValidationError {
+			if taken, _ := ctx.Value(takenKey{}).(string); taken == h.Handle {
+				return &rapidval.ValidationError{Field: "handle", MessageKey: rapidval.MsgUnavailable}
+			}
+			return nil
+		}),
+	}
+}
+
+func TestHookContext(t *testing.T) {
+	build := func(m ent.Mutation) rapidval.Validateable { return &handleInput{Handle: "john"} }
+	mutator := Hook(build)(ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		return nil, nil
+	}))
+
+	ctx := context.WithValue(context.Background(), takenKey{}, "john")
+	_, err := mutator.Mutate(ctx, &fakeMutation{op: ent.OpCreate})
+	verr, ok := err.(rapidval.ValidationErrors)
+	if !ok || len(verr) != 1 || verr[0].MessageKey != rapidval.MsgUnavailable {
+		t.Errorf("Mutate() error = %v, want %s", err, rapidval.MsgUnavailable)
+	}
+}
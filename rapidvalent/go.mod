@@ -0,0 +1,10 @@
+module github.com/9ssi7/rapidval/rapidvalent
+
+go 1.23.0
+
+require (
+	entgo.io/ent v0.14.1
+	github.com/9ssi7/rapidval v0.0.0
+)
+
+replace github.com/9ssi7/rapidval => ../
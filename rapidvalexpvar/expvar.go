@@ -0,0 +1,41 @@
+// Package rapidvalexpvar publishes the failure counters of rapidval Validators with the expvar
+// package, so services without a metrics stack can watch them through the /debug/vars handler:
+//
+//	v := rapidval.New(rapidvalexpvar.WithExpvar("validation"))
+//
+// It is kept out of the core package because expvar depends on net/http, which would grow
+// every program using rapidval, including WebAssembly builds.
+package rapidvalexpvar
+
+import (
+	"expvar"
+	"sync"
+
+	"github.com/9ssi7/rapidval"
+)
+
+var published = struct {
+	sync.Mutex
+	counters map[string]*rapidval.Counters
+}{counters: map[string]*rapidval.Counters{}}
+
+// WithExpvar makes the Validator count its validations and failures in rapidval.Counters published
+// under name. Validators created with the same name share the counters. It panics if name is
+// already published by other code.
+func WithExpvar(name string) rapidval.Option {
+	return rapidval.WithCounters(Publish(name))
+}
+
+// Publish returns the counters published under name, publishing new ones on the first call.
+// It panics if name is already published by other code.
+func Publish(name string) *rapidval.Counters {
+	published.Lock()
+	defer published.Unlock()
+	if c, ok := published.counters[name]; ok {
+		return c
+	}
+	c := new(rapidval.Counters)
+	expvar.Publish(name, c)
+	published.counters[name] = c
+	return c
+}
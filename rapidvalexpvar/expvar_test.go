@@ -0,0 +1,40 @@
+package rapidvalexpvar
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+type signup struct {
+	Email string
+}
+
+func (s *signup) Validations() rapidval.P {
+	return rapidval.P{rapidval.Email("Email", s.Email)}
+}
+
+func TestWithExpvar(t *testing.T) {
+	v := rapidval.New(WithExpvar("rapidval_test"))
+	other := rapidval.New(WithExpvar("rapidval_test"))
+
+	v.Validate(&signup{Email: "invalid"})
+	other.Validate(&signup{Email: "ada@example.com"})
+
+	var got struct {
+		Validations int
+		Failures    int
+		Keys        map[string]int
+	}
+	if err := json.Unmarshal([]byte(expvar.Get("rapidval_test").String()), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Validations != 2 || got.Failures != 1 || got.Keys[rapidval.MsgInvalidEmail] != 1 {
+		t.Errorf("counters = %+v, want 2 validations and 1 invalid email", got)
+	}
+	if Publish("rapidval_test") != Publish("rapidval_test") {
+		t.Error("Publish() returned different counters for the same name")
+	}
+}
@@ -0,0 +1,187 @@
+// Package rapidvalgettext loads rapidval messages from gettext catalogs, so teams exporting
+// .po or .mo files from tools such as Crowdin or Weblate can use them without converting formats.
+//
+// Message ids are rapidval message keys and translations are Translator templates:
+//
+//	msgid "validation.required"
+//	msgstr "{{.Field}} is required"
+//
+// Usage:
+//
+//	messages, err := rapidvalgettext.Load(os.DirFS("locales"), "de/validation.po")
+//	if err != nil {
+//	    return err
+//	}
+//	tr := rapidval.NewTranslatorWithMessages(messages)
+//
+// The header entry, fuzzy entries, untranslated entries and entries with a msgctxt are skipped.
+// For plural entries only the singular form is used.
+package rapidvalgettext
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Load reads a catalog from fsys and parses it according to its extension, ".po" or ".mo".
+func Load(fsys fs.FS, name string) (map[string]string, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch path.Ext(name) {
+	case ".po":
+		return ParsePO(f)
+	case ".mo":
+		return ParseMO(f)
+	}
+	return nil, fmt.Errorf("rapidvalgettext: unsupported catalog extension %q", path.Ext(name))
+}
+
+// poEntry accumulates the fields of a single .po entry.
+type poEntry struct {
+	ctxt, id, str string
+	hasCtxt       bool
+	fuzzy         bool
+	// target is the field that continuation lines are appended to.
+	target *string
+}
+
+// ParsePO parses a gettext .po catalog.
+func ParsePO(r io.Reader) (map[string]string, error) {
+	messages := map[string]string{}
+	var e poEntry
+	flush := func() {
+		if e.id != "" && e.str != "" && !e.fuzzy && !e.hasCtxt {
+			messages[e.id] = e.str
+		}
+		e = poEntry{}
+	}
+
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#,"):
+			if e.target != nil {
+				flush()
+			}
+			e.fuzzy = e.fuzzy || strings.Contains(line, "fuzzy")
+		case strings.HasPrefix(line, "#"):
+			if e.target != nil {
+				flush()
+			}
+		case strings.HasPrefix(line, `"`):
+			if e.target == nil {
+				return nil, fmt.Errorf("rapidvalgettext: line %d: string without keyword", n)
+			}
+			s, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, fmt.Errorf("rapidvalgettext: line %d: %w", n, err)
+			}
+			*e.target += s
+		default:
+			keyword, value, _ := strings.Cut(line, " ")
+			s, err := strconv.Unquote(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("rapidvalgettext: line %d: %w", n, err)
+			}
+			switch keyword {
+			case "msgctxt":
+				if e.target != nil && e.target != &e.ctxt {
+					flush()
+				}
+				e.ctxt, e.hasCtxt, e.target = s, true, &e.ctxt
+			case "msgid":
+				if e.target != nil && e.target != &e.ctxt {
+					flush()
+				}
+				e.id, e.target = s, &e.id
+			case "msgstr", "msgstr[0]":
+				e.str, e.target = s, &e.str
+			default:
+				// msgid_plural and the remaining plural forms are not used.
+				var discard string
+				e.target = &discard
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return messages, nil
+}
+
+// moMagic is the magic number of .mo files, which may be stored in either byte order.
+const moMagic = 0x950412de
+
+// ParseMO parses a compiled gettext .mo catalog.
+func ParseMO(r io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 20 {
+		return nil, errors.New("rapidvalgettext: .mo file too short")
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if order.Uint32(data) != moMagic {
+		order = binary.BigEndian
+		if order.Uint32(data) != moMagic {
+			return nil, errors.New("rapidvalgettext: not a .mo file")
+		}
+	}
+	count := order.Uint32(data[8:])
+	origTable := order.Uint32(data[12:])
+	transTable := order.Uint32(data[16:])
+
+	str := func(table uint32, i uint32) ([]byte, error) {
+		pos := uint64(table) + uint64(i)*8
+		if pos+8 > uint64(len(data)) {
+			return nil, errors.New("rapidvalgettext: .mo string table out of range")
+		}
+		length := uint64(order.Uint32(data[pos:]))
+		offset := uint64(order.Uint32(data[pos+4:]))
+		if offset+length > uint64(len(data)) {
+			return nil, errors.New("rapidvalgettext: .mo string out of range")
+		}
+		return data[offset : offset+length], nil
+	}
+
+	messages := make(map[string]string, count)
+	for i := uint32(0); i < count; i++ {
+		id, err := str(origTable, i)
+		if err != nil {
+			return nil, err
+		}
+		tr, err := str(transTable, i)
+		if err != nil {
+			return nil, err
+		}
+		// Contexts are stored as "ctxt\x04id", plural ids and forms are separated by NUL.
+		if len(id) == 0 || bytes.IndexByte(id, 4) >= 0 {
+			continue
+		}
+		id, _, _ = bytes.Cut(id, []byte{0})
+		tr, _, _ = bytes.Cut(tr, []byte{0})
+		if len(tr) == 0 {
+			continue
+		}
+		messages[string(id)] = string(tr)
+	}
+	return messages, nil
+}
@@ -0,0 +1,149 @@
+package rapidvalgettext
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/9ssi7/rapidval"
+)
+
+const testPO = `# German translations
+msgid ""
+msgstr ""
+"Content-Type: text/plain; charset=UTF-8\n"
+
+#: rules.go:12
+msgid "validation.required"
+msgstr "{{.Field}} ist erforderlich"
+
+msgid "validation.min_length"
+msgstr ""
+"{{.Field}} muss mindestens "
+"{{.Min}} Zeichen lang sein"
+
+#, fuzzy
+msgid "validation.email"
+msgstr "{{.Field}} ist keine E-Mail"
+
+msgid "validation.max_length"
+msgstr ""
+
+msgctxt "admin"
+msgid "validation.required"
+msgstr "Pflichtfeld"
+
+msgid "validation.max_count"
+msgid_plural "validation.max_count"
+msgstr[0] "{{.Field}} \"zu oft\""
+msgstr[1] "ignored"
+`
+
+func TestParsePO(t *testing.T) {
+	messages, err := ParsePO(strings.NewReader(testPO))
+	if err != nil {
+		t.Fatalf("ParsePO() error = %v", err)
+	}
+
+	want := map[string]string{
+		rapidval.MsgRequired:  "{{.Field}} ist erforderlich",
+		rapidval.MsgMinLength: "{{.Field}} muss mindestens {{.Min}} Zeichen lang sein",
+		rapidval.MsgMaxCount:  `{{.Field}} "zu oft"`,
+	}
+	if len(messages) != len(want) {
+		t.Errorf("ParsePO() = %v, want %v", messages, want)
+	}
+	for key, msg := range want {
+		if messages[key] != msg {
+			t.Errorf("messages[%q] = %q, want %q", key, messages[key], msg)
+		}
+	}
+
+	tr := rapidval.NewTranslatorWithMessages(messages)
+	got := tr.Translate(rapidval.Required("Name", ""))
+	if got != "Name ist erforderlich" {
+		t.Errorf("Translate() = %q", got)
+	}
+}
+
+func TestParsePOErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		po   string
+	}{
+		{"dangling string", `"no keyword"`},
+		{"unquoted", "msgid validation.required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePO(strings.NewReader(tt.po)); err == nil {
+				t.Error("ParsePO() should fail")
+			}
+		})
+	}
+}
+
+// buildMO encodes pairs of original and translated strings as a little-endian .mo file.
+func buildMO(pairs [][2]string) []byte {
+	n := uint32(len(pairs))
+	origTable := uint32(28)
+	transTable := origTable + n*8
+	offset := transTable + n*8
+
+	header := make([]byte, offset)
+	binary.LittleEndian.PutUint32(header[0:], moMagic)
+	binary.LittleEndian.PutUint32(header[8:], n)
+	binary.LittleEndian.PutUint32(header[12:], origTable)
+	binary.LittleEndian.PutUint32(header[16:], transTable)
+
+	var strs []byte
+	for col, table := range []uint32{origTable, transTable} {
+		for i, p := range pairs {
+			s := p[col]
+			binary.LittleEndian.PutUint32(header[table+uint32(i)*8:], uint32(len(s)))
+			binary.LittleEndian.PutUint32(header[table+uint32(i)*8+4:], offset+uint32(len(strs)))
+			strs = append(strs, s...)
+			strs = append(strs, 0)
+		}
+	}
+	return append(header, strs...)
+}
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"de/validation.po": {Data: []byte(testPO)},
+		"de/validation.mo": {Data: buildMO([][2]string{
+			{"", "Content-Type: text/plain; charset=UTF-8\n"},
+			{"admin\x04validation.required", "Pflichtfeld"},
+			{"validation.max_count\x00validation.max_count", "zu oft\x00ignored"},
+			{"validation.required", "{{.Field}} ist erforderlich"},
+		})},
+		"de/validation.json": {Data: []byte("{}")},
+	}
+
+	po, err := Load(fsys, "de/validation.po")
+	if err != nil || po[rapidval.MsgRequired] != "{{.Field}} ist erforderlich" {
+		t.Errorf("Load(.po) = %v, %v", po, err)
+	}
+
+	mo, err := Load(fsys, "de/validation.mo")
+	if err != nil {
+		t.Fatalf("Load(.mo) error = %v", err)
+	}
+	want := map[string]string{
+		rapidval.MsgRequired: "{{.Field}} ist erforderlich",
+		rapidval.MsgMaxCount: "zu oft",
+	}
+	if len(mo) != len(want) || mo[rapidval.MsgRequired] != want[rapidval.MsgRequired] || mo[rapidval.MsgMaxCount] != want[rapidval.MsgMaxCount] {
+		t.Errorf("Load(.mo) = %v, want %v", mo, want)
+	}
+
+	if _, err := Load(fsys, "de/validation.json"); err == nil {
+		t.Error("Load() with unsupported extension should fail")
+	}
+	if _, err := ParseMO(strings.NewReader("not a catalog, but long enough")); err == nil {
+		t.Error("ParseMO() with bad magic should fail")
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/9ssi7/rapidval/rapidvalgorm
+
+go 1.23.0
+
+require (
+	github.com/9ssi7/rapidval v0.0.0
+	gorm.io/gorm v1.25.12
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/text v0.14.0 // indirect
+)
+
+replace github.com/9ssi7/rapidval => ../
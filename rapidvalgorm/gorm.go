@@ -0,0 +1,117 @@
+// Package rapidvalgorm validates GORM models implementing rapidval.Validateable before they are persisted.
+//
+//	db, _ := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+//	if err := rapidvalgorm.Register(db); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	err := db.Create(&user).Error // rapidval.ValidationErrors when user is invalid
+//
+// Validation runs before GORM's create and update steps, so an invalid model aborts the statement
+// (and the surrounding transaction) with ValidationErrors. Updates expressed as maps or column
+// assignments are not visible to the model and are therefore not validated. Models are validated
+// under the context of the statement; use RegisterWithValidator to validate with a configured
+// Validator, e.g. one created with rapidval.WithTimeout.
+package rapidvalgorm
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"github.com/9ssi7/rapidval"
+	"gorm.io/gorm"
+)
+
+const (
+	createCallback = "rapidval:validate_create"
+	updateCallback = "rapidval:validate_update"
+)
+
+// Register installs the validation callbacks on db for create and update statements.
+func Register(db *gorm.DB) error {
+	return RegisterWithValidator(db, rapidval.New())
+}
+
+// RegisterWithValidator is like Register but validates models with v.
+func RegisterWithValidator(db *gorm.DB, v *rapidval.Validator) error {
+	validate := func(db *gorm.DB) {
+		if db.Error != nil || !db.Statement.ReflectValue.IsValid() {
+			return
+		}
+		if err := validateValue(db.Statement.Context, v, db.Statement.ReflectValue); err != nil {
+			db.AddError(err)
+		}
+	}
+	if err := db.Callback().Create().Before("gorm:create").Register(createCallback, validate); err != nil {
+		return err
+	}
+	return db.Callback().Update().Before("gorm:update").Register(updateCallback, validate)
+}
+
+// Validate validates model the same way the registered callbacks do.
+// It can be called from a model's own BeforeSave hook when global callbacks are not desired.
+func Validate(model interface{}) error {
+	return ValidateContext(context.Background(), model)
+}
+
+// ValidateContext is like Validate but passes ctx to the rules, e.g. the tx.Statement.Context of a hook.
+func ValidateContext(ctx context.Context, model interface{}) error {
+	return validateValue(ctx, rapidval.New(), reflect.ValueOf(model))
+}
+
+// validateValue validates a model, or every model of a batch insert.
+// Errors of batch elements are prefixed with their index, e.g. "[2].Email".
+func validateValue(ctx context.Context, v *rapidval.Validator, rv reflect.Value) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		if val, ok := rv.Interface().(rapidval.Validateable); ok {
+			return v.ValidateContext(ctx, val)
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		var errs rapidval.ValidationErrors
+		for i := 0; i < rv.Len(); i++ {
+			err := validateValue(ctx, v, addr(rv.Index(i)))
+			verrs, ok := err.(rapidval.ValidationErrors)
+			if !ok {
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			prefix := "[" + strconv.Itoa(i) + "]."
+			for _, ve := range verrs {
+				ve.Field = prefix + ve.Field
+				if ve.MessageParams != nil {
+					ve.MessageParams[rapidval.Field] = ve.Field
+				}
+			}
+			errs = append(errs, verrs...)
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+	case reflect.Struct:
+		if val, ok := addr(rv).Interface().(rapidval.Validateable); ok {
+			return v.ValidateContext(ctx, val)
+		}
+	}
+	return nil
+}
+
+// addr returns a pointer to rv when possible, since Validations is usually declared on pointer receivers.
+func addr(rv reflect.Value) reflect.Value {
+	if rv.Kind() != reflect.Ptr && rv.CanAddr() {
+		return rv.Addr()
+	}
+	return rv
+}
@@ -0,0 +1,92 @@
+package rapidvalgorm
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+type user struct {
+	Name  string
+	Email string
+}
+
+func (u *user) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.Required("Name", u.Name),
+		rapidval.Email("Email", u.Email),
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(&user{Name: "John", Email: "john@example.com"}); err != nil {
+		t.Errorf("Validate() returned error: %v", err)
+	}
+
+	verr, ok := Validate(&user{Email: "invalid"}).(rapidval.ValidationErrors)
+	if !ok || len(verr) != 2 {
+		t.Errorf("Validate() = %v, want 2 errors", verr)
+	}
+
+	if err := Validate(&struct{ Name string }{}); err != nil {
+		t.Errorf("Validate() of non validateable model returned error: %v", err)
+	}
+}
+
+func TestValidateBatch(t *testing.T) {
+	users := []user{
+		{Name: "John", Email: "john@example.com"},
+		{Name: "Jane", Email: "invalid"},
+	}
+	verr, ok := Validate(&users).(rapidval.ValidationErrors)
+	if !ok || len(verr) != 1 {
+		t.Fatalf("Validate() = %v, want 1 error", verr)
+	}
+	if verr[0].Field != "[1].Email" {
+		t.Errorf("Field = %v, want [1].Email", verr[0].Field)
+	}
+
+	pointers := []*user{nil, {Email: "john@example.com"}}
+	verr, ok = Validate(pointers).(rapidval.ValidationErrors)
+	if !ok || len(verr) != 1 || verr[0].Field != "[1].Name" {
+		t.Errorf("Validate() = %v, want [1].Name error", verr)
+	}
+}
+
+type blockedKey struct{}
+
+type account struct {
+	Email string
+}
+
+func (a *account) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.RuleFunc(func(ctx context.Context) *rapidval.ValidationError {
+			if blocked, _ := ctx.Value(blockedKey{}).(string); blocked == a.Email {
+				return &rapidval.ValidationError{Field: "Email", MessageKey: rapidval.MsgUnavailable}
+			}
+			return nil
+		}),
+	}
+}
+
+func TestValidateContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), blockedKey{}, "john@example.com")
+	verr, ok := ValidateContext(ctx, &account{Email: "john@example.com"}).(rapidval.ValidationErrors)
+	if !ok || len(verr) != 1 || verr[0].MessageKey != rapidval.MsgUnavailable {
+		t.Errorf("ValidateContext() = %v, want %s", verr, rapidval.MsgUnavailable)
+	}
+	if err := Validate(&account{Email: "john@example.com"}); err != nil {
+		t.Errorf("Validate() returned error: %v", err)
+	}
+}
+
+func TestValidateWithValidator(t *testing.T) {
+	v := rapidval.New(rapidval.WithKeyPrefix("users."))
+	verr, ok := validateValue(context.Background(), v, reflect.ValueOf(&user{Name: "John"})).(rapidval.ValidationErrors)
+	if !ok || len(verr) != 1 || verr[0].MessageKey != "users."+rapidval.MsgInvalidEmail {
+		t.Errorf("validateValue() = %v, want users.%s", verr, rapidval.MsgInvalidEmail)
+	}
+}
@@ -0,0 +1,47 @@
+package rapidvalhttp
+
+import (
+	"net/http"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// BindAndValidate decodes the JSON body of r into a new T and validates it:
+//
+//	order, err := rapidvalhttp.BindAndValidate[CreateOrder](r)
+//
+// A missing body is reported as MsgRequired and a malformed one as MsgInvalidJSON, as
+// ValidationErrors without a field, so every error can be written with Envelope.Write.
+// The decoded value is returned even if it is invalid.
+func BindAndValidate[T any, PT interface {
+	*T
+	rapidval.Validateable
+}](r *http.Request) (*T, error) {
+	val := new(T)
+	if errs := decodeBody(r, val); errs != nil {
+		return nil, errs
+	}
+	return val, rapidval.New().ValidateContext(r.Context(), PT(val))
+}
+
+// Handle returns a handler that binds and validates the JSON request body like BindAndValidate
+// and calls fn with the valid value. Invalid requests are answered by env, with status 422 for
+// validation errors by default:
+//
+//	mux.Handle("POST /orders", rapidvalhttp.Handle(rapidvalhttp.Envelope{},
+//	    func(w http.ResponseWriter, r *http.Request, order *CreateOrder) {
+//	        // order is valid
+//	    }))
+func Handle[T any, PT interface {
+	*T
+	rapidval.Validateable
+}](env Envelope, fn func(w http.ResponseWriter, r *http.Request, val *T)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		val, err := BindAndValidate[T, PT](r)
+		if err != nil {
+			env.Write(w, r, err)
+			return
+		}
+		fn(w, r, val)
+	})
+}
@@ -0,0 +1,69 @@
+package rapidvalhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+func TestBindAndValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		want     *createOrder
+		wantErrs map[string]string
+	}{
+		{"valid", `{"sku":"A-1","quantity":2}`, &createOrder{SKU: "A-1", Quantity: 2}, nil},
+		{"invalid", `{"quantity":20}`, &createOrder{Quantity: 20}, map[string]string{"SKU": rapidval.MsgRequired, "Quantity": rapidval.MsgBetween}},
+		{"empty body", ``, nil, map[string]string{"": rapidval.MsgRequired}},
+		{"malformed", `{"sku":`, nil, map[string]string{"": rapidval.MsgInvalidJSON}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(tt.body))
+			got, err := BindAndValidate[createOrder](r)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BindAndValidate() = %+v, want %+v", got, tt.want)
+			}
+			if keys := errorKeys(t, err); !reflect.DeepEqual(keys, tt.wantErrs) {
+				t.Errorf("BindAndValidate() errors = %v, want %v", keys, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	h := Handle(Envelope{}, func(w http.ResponseWriter, r *http.Request, order *createOrder) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(order.SKU))
+	})
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{"valid", `{"sku":"A-1","quantity":2}`, http.StatusCreated, `A-1`},
+		{"invalid", `{"sku":"A-1"}`, http.StatusUnprocessableEntity, `{"errors":[{"field":"Quantity","key":"validation.between","message":"validation.between"}]}`},
+		{"malformed", `[`, http.StatusUnprocessableEntity, `{"errors":[{"key":"validation.json","message":"validation.json"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(tt.body)))
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %s, want %s", got, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package rapidvalhttp
+
+import (
+	"net/http"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// RequestID returns a middleware storing the value of the named request header, e.g. "X-Request-ID",
+// as the correlation ID of the request context, where Envelope.Write and rapidval.LogAttrs find it.
+// Requests without the header keep the correlation ID resolved by rapidval.CorrelationIDFunc, if any.
+func RequestID(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if id := r.Header.Get(header); id != "" {
+				r = r.WithContext(rapidval.WithCorrelationID(r.Context(), id))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
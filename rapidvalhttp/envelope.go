@@ -0,0 +1,175 @@
+package rapidvalhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// Envelope describes the JSON body validation errors are written in, so responses can follow an
+// existing error contract. The zero Envelope writes the body used across rapidval integrations:
+//
+//	{"errors": [{"field": "Email", "key": "validation.email", "message": "..."}]}
+type Envelope struct {
+	// ErrorsKey is the top-level member holding the list of errors. It defaults to "errors".
+	ErrorsKey string
+	// FieldKey, CodeKey, MessageKey and ParamsKey name the members of each error.
+	// They default to "field", "key", "message" and "params".
+	FieldKey, CodeKey, MessageKey, ParamsKey string
+	// OmitCode leaves out the message key of each error.
+	OmitCode bool
+	// IncludeParams adds the message params of each error, such as Min and Max, encoded like
+	// rapidval.ValidationError.JSONParams: without Field and without params that cannot be encoded.
+	IncludeParams bool
+	// IncludeValues adds the Value param, the invalid input, to the params of IncludeParams.
+	// It is left out by default so that responses do not echo input back.
+	IncludeValues bool
+	// FieldName rewrites field names, e.g. LowerCamel to report "address.city" for "Address.City".
+	FieldName func(field string) string
+	// StatusKey, if set, is the top-level member holding the HTTP status.
+	StatusKey string
+	// CorrelationIDKey, if set, is the top-level member holding the correlation ID of the request
+	// (see rapidval.CorrelationID and RequestID). It is left out when the request has none.
+	CorrelationIDKey string
+	// Members are added to the top level of every body, e.g. a "type" or "title".
+	Members map[string]interface{}
+	// ContentType defaults to "application/json".
+	ContentType string
+	// Statuses maps message keys to the response status. It defaults to rapidval.DefaultStatusMap.
+	Statuses rapidval.StatusMap
+}
+
+// ProblemDetails returns an Envelope writing RFC 9457 problem details with the
+// validation errors in the "errors" extension member:
+//
+//	{"type": "about:blank", "title": "...", "status": 422, "errors": [...]}
+func ProblemDetails(title string) Envelope {
+	return Envelope{
+		StatusKey:   "status",
+		Members:     map[string]interface{}{"type": "about:blank", "title": title},
+		ContentType: "application/problem+json",
+	}
+}
+
+var defaultStatuses = rapidval.DefaultStatusMap()
+
+// Body returns the response status and body for err. Messages are translated with tr, or
+// left as message keys if tr is nil, and the correlation ID is read from ctx. Errors that are not rapidval validation errors produce
+// a 500 status and an empty list of errors, so internal details are not exposed.
+func (e Envelope) Body(ctx context.Context, err error, tr *rapidval.Translator) (int, map[string]interface{}) {
+	statuses := e.Statuses
+	if statuses == nil {
+		statuses = defaultStatuses
+	}
+	status := statuses.Status(err)
+
+	var verrs rapidval.ValidationErrors
+	if !errors.As(err, &verrs) {
+		var verr *rapidval.ValidationError
+		if errors.As(err, &verr) {
+			verrs = rapidval.ValidationErrors{verr}
+		}
+	}
+
+	list := make([]interface{}, 0, len(verrs))
+	for _, ve := range verrs {
+		list = append(list, e.error(ve, tr))
+	}
+
+	body := make(map[string]interface{}, len(e.Members)+2)
+	for k, v := range e.Members {
+		body[k] = v
+	}
+	if e.StatusKey != "" {
+		body[e.StatusKey] = status
+	}
+	if e.CorrelationIDKey != "" {
+		if id := rapidval.CorrelationID(ctx); id != "" {
+			body[e.CorrelationIDKey] = id
+		}
+	}
+	body[or(e.ErrorsKey, "errors")] = list
+	return status, body
+}
+
+func (e Envelope) error(ve *rapidval.ValidationError, tr *rapidval.Translator) map[string]interface{} {
+	msg := ve.MessageKey
+	if tr != nil {
+		msg = tr.Translate(ve)
+	}
+	m := map[string]interface{}{or(e.MessageKey, "message"): msg}
+	if ve.Field != "" {
+		field := ve.Field
+		if e.FieldName != nil {
+			field = e.FieldName(field)
+		}
+		m[or(e.FieldKey, "field")] = field
+	}
+	if !e.OmitCode {
+		m[or(e.CodeKey, "key")] = ve.MessageKey
+	}
+	if e.IncludeParams {
+		params := ve.JSONParams(e.IncludeValues)
+		if params == nil {
+			params = map[string]json.RawMessage{}
+		}
+		m[or(e.ParamsKey, "params")] = params
+	}
+	return m
+}
+
+// Write writes err to w in the envelope, translated with the translator of the request
+// context (see Middleware) and with the correlation ID of the request context.
+func (e Envelope) Write(w http.ResponseWriter, r *http.Request, err error) {
+	status, body := e.Body(r.Context(), err, FromContext(r.Context()))
+	b, merr := json.Marshal(body)
+	if merr != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", or(e.ContentType, "application/json"))
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+// LowerCamel lowers the leading upper-case letters of every segment of a field name, e.g.
+// "Address.City" becomes "address.city", "Lines[2].SKU" "lines[2].sku" and "URLPath" "urlPath".
+// It is meant for Envelope.FieldName when Go field names are reported for camelCase JSON members.
+func LowerCamel(field string) string {
+	b := []byte(field)
+	for i := 0; i < len(b); {
+		n := 0
+		for i+n < len(b) && b[i+n] >= 'A' && b[i+n] <= 'Z' {
+			n++
+		}
+		// Keep the last letter of an acronym when it starts the next word: "URLPath" → "urlPath".
+		if n > 1 && i+n < len(b) && b[i+n] >= 'a' && b[i+n] <= 'z' {
+			n--
+		}
+		for k := i; k < i+n; k++ {
+			b[k] += 'a' - 'A'
+		}
+		for i < len(b) && b[i] != '.' {
+			i++
+		}
+		i++
+	}
+	return string(b)
+}
+
+// JSONPointer rewrites a field name as a JSON Pointer, e.g. "Lines[2].Unit/Price" becomes
+// "/Lines/2/Unit~1Price". It is meant for Envelope.FieldName when clients address invalid members
+// by pointer, as in the invalid-params of RFC 9457 problem details.
+func JSONPointer(field string) string {
+	return rapidval.ParsePath(field).JSONPointer()
+}
+
+func or(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,178 @@
+package rapidvalhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+func TestEnvelopeBody(t *testing.T) {
+	errs := rapidval.ValidationErrors{
+		rapidval.MinLength("Address.City", "A", 2),
+		{MessageKey: rapidval.MsgInternal, MessageParams: map[string]interface{}{}},
+	}
+
+	tests := []struct {
+		name       string
+		env        Envelope
+		err        error
+		wantStatus int
+		want       string
+	}{
+		{
+			name:       "default",
+			err:        errs[:1],
+			wantStatus: http.StatusUnprocessableEntity,
+			want:       `{"errors":[{"field":"Address.City","key":"validation.min_length","message":"validation.min_length"}]}`,
+		},
+		{
+			name: "custom keys",
+			env: Envelope{
+				ErrorsKey:     "details",
+				FieldKey:      "pointer",
+				CodeKey:       "code",
+				MessageKey:    "detail",
+				IncludeParams: true,
+				FieldName:     LowerCamel,
+				Members:       map[string]interface{}{"code": "VALIDATION_FAILED"},
+			},
+			err:        errs[:1],
+			wantStatus: http.StatusUnprocessableEntity,
+			want:       `{"code":"VALIDATION_FAILED","details":[{"code":"validation.min_length","detail":"validation.min_length","params":{"Min":2},"pointer":"address.city"}]}`,
+		},
+		{
+			name:       "params with values",
+			env:        Envelope{IncludeParams: true, IncludeValues: true},
+			err:        errs[:1],
+			wantStatus: http.StatusUnprocessableEntity,
+			want:       `{"errors":[{"field":"Address.City","key":"validation.min_length","message":"validation.min_length","params":{"Min":2,"Value":"A"}}]}`,
+		},
+		{
+			name: "unencodable params",
+			env:  Envelope{IncludeParams: true, IncludeValues: true},
+			err: &rapidval.ValidationError{Field: "Total", MessageKey: rapidval.MsgMin, MessageParams: map[string]interface{}{
+				rapidval.Min: 1, rapidval.Value: complex(1, 2), "Check": func() {},
+			}},
+			wantStatus: http.StatusUnprocessableEntity,
+			want:       `{"errors":[{"field":"Total","key":"validation.min","message":"validation.min","params":{"Min":1}}]}`,
+		},
+		{
+			name:       "omit code and field-less error",
+			env:        Envelope{OmitCode: true},
+			err:        errs[1:],
+			wantStatus: http.StatusInternalServerError,
+			want:       `{"errors":[{"message":"validation.internal"}]}`,
+		},
+		{
+			name:       "problem details",
+			env:        ProblemDetails("Validation failed"),
+			err:        errs[:1],
+			wantStatus: http.StatusUnprocessableEntity,
+			want:       `{"errors":[{"field":"Address.City","key":"validation.min_length","message":"validation.min_length"}],"status":422,"title":"Validation failed","type":"about:blank"}`,
+		},
+		{
+			name:       "correlation id without one in context",
+			env:        Envelope{CorrelationIDKey: "requestId", OmitCode: true},
+			err:        errs[:1],
+			wantStatus: http.StatusUnprocessableEntity,
+			want:       `{"errors":[{"field":"Address.City","message":"validation.min_length"}]}`,
+		},
+		{
+			name:       "custom statuses",
+			env:        Envelope{Statuses: rapidval.StatusMap{rapidval.MsgMinLength: http.StatusBadRequest}},
+			err:        errs[:1],
+			wantStatus: http.StatusBadRequest,
+			want:       `{"errors":[{"field":"Address.City","key":"validation.min_length","message":"validation.min_length"}]}`,
+		},
+		{
+			name:       "other error",
+			err:        errors.New("database is down"),
+			wantStatus: http.StatusInternalServerError,
+			want:       `{"errors":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := tt.env.Body(context.Background(), tt.err, nil)
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			b, _ := json.Marshal(body)
+			if string(b) != tt.want {
+				t.Errorf("body = %s\nwant   %s", b, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvelopeWrite(t *testing.T) {
+	tr := rapidval.NewTranslator()
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r = r.WithContext(WithTranslator(r.Context(), tr))
+	w := httptest.NewRecorder()
+
+	r.Header.Set("X-Request-ID", "req-42")
+	env := ProblemDetails("Validation failed")
+	env.CorrelationIDKey = "requestId"
+	RequestID("X-Request-ID")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		env.Write(w, r, rapidval.ValidationErrors{rapidval.Required("Name", "")})
+	})).ServeHTTP(w, r)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusUnprocessableEntity)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	var body struct {
+		Status    int
+		RequestID string
+		Errors    []map[string]string
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]string{{"field": "Name", "key": rapidval.MsgRequired, "message": "Name alanı zorunludur"}}
+	if body.Status != http.StatusUnprocessableEntity || body.RequestID != "req-42" || !reflect.DeepEqual(body.Errors, want) {
+		t.Errorf("body = %s", w.Body)
+	}
+}
+
+func TestLowerCamel(t *testing.T) {
+	tests := map[string]string{
+		"Name":          "name",
+		"Address.City":  "address.city",
+		"Lines[2].SKU":  "lines[2].sku",
+		"URLPath":       "urlPath",
+		"UserID":        "userID",
+		"ID":            "id",
+		"header.X-Mode": "header.x-Mode",
+		"":              "",
+	}
+	for in, want := range tests {
+		if got := LowerCamel(in); got != want {
+			t.Errorf("LowerCamel(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	tests := map[string]string{
+		"Name":                "/Name",
+		"Lines[2].Unit/Price": "/Lines/2/Unit~1Price",
+		"body.tags[0]":        "/body/tags/0",
+		"/items/2/a~1b":       "/items/2/a~1b",
+		"":                    "",
+	}
+	for in, want := range tests {
+		if got := JSONPointer(in); got != want {
+			t.Errorf("JSONPointer(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
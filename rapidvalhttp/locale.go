@@ -0,0 +1,129 @@
+package rapidvalhttp
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// Locales maps locale tags to translators and configures how the locale of a request is resolved.
+type Locales struct {
+	// Translators holds a translator per locale tag, e.g. "en", "tr" or "pt-BR". Tags are matched case-insensitively.
+	Translators map[string]*rapidval.Translator
+	// Default is the tag used when the request names no supported locale.
+	Default string
+	// Query is the name of a query parameter selecting the locale, e.g. "lang". Empty disables it.
+	Query string
+	// Cookie is the name of a cookie selecting the locale. Empty disables it.
+	Cookie string
+}
+
+type translatorKey struct{}
+
+// WithTranslator returns a copy of ctx that carries tr.
+func WithTranslator(ctx context.Context, tr *rapidval.Translator) context.Context {
+	return context.WithValue(ctx, translatorKey{}, tr)
+}
+
+// FromContext returns the translator stored by Middleware or WithTranslator, or nil if there is none.
+func FromContext(ctx context.Context) *rapidval.Translator {
+	tr, _ := ctx.Value(translatorKey{}).(*rapidval.Translator)
+	return tr
+}
+
+// Middleware resolves the locale of each request once and stores the matching translator in the
+// request context, where handlers and error writers retrieve it with FromContext.
+//
+// The locale is taken from the query parameter, then the cookie, then the Accept-Language header,
+// using the first supported one. A regional tag such as "de-AT" falls back to its language "de".
+func Middleware(l Locales) func(http.Handler) http.Handler {
+	translators := make(map[string]*rapidval.Translator, len(l.Translators))
+	for tag, tr := range l.Translators {
+		translators[strings.ToLower(tag)] = tr
+	}
+	fallback := translators[strings.ToLower(l.Default)]
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tr := resolve(r, l, translators)
+			if tr == nil {
+				tr = fallback
+			}
+			if tr != nil {
+				r = r.WithContext(WithTranslator(r.Context(), tr))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func resolve(r *http.Request, l Locales, translators map[string]*rapidval.Translator) *rapidval.Translator {
+	if l.Query != "" {
+		if tr := match(translators, r.URL.Query().Get(l.Query)); tr != nil {
+			return tr
+		}
+	}
+	if l.Cookie != "" {
+		if c, err := r.Cookie(l.Cookie); err == nil {
+			if tr := match(translators, c.Value); tr != nil {
+				return tr
+			}
+		}
+	}
+	for _, tag := range acceptLanguage(r.Header.Get("Accept-Language")) {
+		if tr := match(translators, tag); tr != nil {
+			return tr
+		}
+	}
+	return nil
+}
+
+// match looks up tag, falling back to its base language.
+func match(translators map[string]*rapidval.Translator, tag string) *rapidval.Translator {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return nil
+	}
+	if tr, ok := translators[tag]; ok {
+		return tr
+	}
+	if base, _, ok := strings.Cut(tag, "-"); ok {
+		return translators[base]
+	}
+	return nil
+}
+
+// acceptLanguage returns the tags of an Accept-Language header ordered by preference.
+func acceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if q > 0 {
+			tags = append(tags, weighted{tag, q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	out := make([]string, len(tags))
+	for i, t := range tags {
+		out[i] = t.tag
+	}
+	return out
+}
@@ -0,0 +1,67 @@
+package rapidvalhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+func TestMiddleware(t *testing.T) {
+	en := rapidval.NewTranslatorWithMessages(map[string]string{rapidval.MsgRequired: "{{.Field}} is required"})
+	de := rapidval.NewTranslatorWithMessages(map[string]string{rapidval.MsgRequired: "{{.Field}} ist erforderlich"})
+	tr := rapidval.NewTranslator()
+
+	mw := Middleware(Locales{
+		Translators: map[string]*rapidval.Translator{"en": en, "de": de, "tr": tr},
+		Default:     "en",
+		Query:       "lang",
+		Cookie:      "locale",
+	})
+
+	tests := []struct {
+		name   string
+		target string
+		cookie string
+		accept string
+		want   *rapidval.Translator
+	}{
+		{"default", "/", "", "", en},
+		{"accept language", "/", "", "de-AT,de;q=0.9,en;q=0.8", de},
+		{"accept language q order", "/", "", "en;q=0.5, tr", tr},
+		{"unsupported accept language", "/", "", "fr-FR,fr;q=0.9", en},
+		{"zero q ignored", "/", "", "de;q=0, tr;q=0.1", tr},
+		{"cookie over header", "/", "tr", "de", tr},
+		{"query over cookie", "/?lang=DE", "tr", "", de},
+		{"unsupported query", "/?lang=fr", "", "tr", tr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: "locale", Value: tt.cookie})
+			}
+			if tt.accept != "" {
+				r.Header.Set("Accept-Language", tt.accept)
+			}
+
+			var got *rapidval.Translator
+			mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = FromContext(r.Context())
+			})).ServeHTTP(httptest.NewRecorder(), r)
+
+			if got != tt.want {
+				t.Errorf("FromContext() = %p, want %p", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromContextEmpty(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if tr := FromContext(r.Context()); tr != nil {
+		t.Errorf("FromContext() = %v, want nil", tr)
+	}
+}
@@ -0,0 +1,147 @@
+// Package rapidvalhttp provides net/http helpers for rapidval.
+package rapidvalhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/9ssi7/rapidval"
+	"github.com/9ssi7/rapidval/schema"
+)
+
+// RequestSpec declares the parts of an http.Request to validate.
+// Every part is optional; nil parts are not validated.
+type RequestSpec struct {
+	// Headers validates request headers. Parameter names are canonicalized like http.Header does.
+	Headers *schema.ValuesSchema
+	// Query validates the URL query string.
+	Query *schema.ValuesSchema
+	// Path validates path parameters resolved with PathValue.
+	Path *schema.ValuesSchema
+	// PathValue resolves a path parameter by name. It defaults to (*http.Request).PathValue,
+	// and can be set to the lookup function of any router.
+	PathValue func(r *http.Request, name string) string
+	// Body receives the JSON-decoded request body, which is then validated.
+	Body rapidval.Validateable
+	// BodySchema validates the JSON request body against a runtime schema.
+	// It is ignored when Body is set.
+	BodySchema *schema.ObjectSchema
+	// Validator validates Body, e.g. one created with rapidval.WithTimeout. It defaults to rapidval.New().
+	Validator *rapidval.Validator
+}
+
+// ValidateRequest validates headers, query, path parameters and JSON body of r in one pass.
+// Failures are reported with fields prefixed by their location: "header.", "query.", "path." and "body.".
+// It returns ValidationErrors, or nil if the request is valid. The request body is consumed when
+// Body or BodySchema is set. Body is validated with the context of r, so lazy rules and timeouts
+// see the cancellation of the request.
+func ValidateRequest(r *http.Request, spec RequestSpec) error {
+	var errs rapidval.ValidationErrors
+
+	if spec.Headers != nil {
+		headers := make(url.Values, len(spec.Headers.Names()))
+		for _, name := range spec.Headers.Names() {
+			if values := r.Header.Values(name); len(values) > 0 {
+				headers[name] = values
+			}
+		}
+		errs = appendPrefixed(errs, "header.", spec.Headers.Validate(headers))
+	}
+
+	if spec.Query != nil {
+		errs = appendPrefixed(errs, "query.", spec.Query.Validate(r.URL.Query()))
+	}
+
+	if spec.Path != nil {
+		lookup := spec.PathValue
+		if lookup == nil {
+			lookup = func(r *http.Request, name string) string { return r.PathValue(name) }
+		}
+		params := make(url.Values, len(spec.Path.Names()))
+		for _, name := range spec.Path.Names() {
+			if v := lookup(r, name); v != "" {
+				params[name] = []string{v}
+			}
+		}
+		errs = appendPrefixed(errs, "path.", spec.Path.Validate(params))
+	}
+
+	if spec.Body != nil || spec.BodySchema != nil {
+		errs = appendPrefixed(errs, "body.", validateBody(r, spec))
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateBody(r *http.Request, spec RequestSpec) rapidval.ValidationErrors {
+	var target interface{} = spec.Body
+	var generic interface{}
+	if spec.Body == nil {
+		target = &generic
+	}
+
+	if errs := decodeBody(r, target); errs != nil {
+		return errs
+	}
+
+	var err error
+	if spec.Body != nil {
+		v := spec.Validator
+		if v == nil {
+			v = rapidval.New()
+		}
+		err = v.ValidateContext(r.Context(), spec.Body)
+	} else {
+		err = schema.Validate(spec.BodySchema, generic)
+	}
+
+	var verrs rapidval.ValidationErrors
+	errors.As(err, &verrs)
+	return verrs
+}
+
+// decodeBody decodes the JSON body of r into target. A missing body is reported as MsgRequired
+// and a malformed one as MsgInvalidJSON.
+func decodeBody(r *http.Request, target interface{}) rapidval.ValidationErrors {
+	if r.Body == nil {
+		return bodyError(rapidval.MsgRequired)
+	}
+	if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+		if errors.Is(err, io.EOF) {
+			return bodyError(rapidval.MsgRequired)
+		}
+		return bodyError(rapidval.MsgInvalidJSON)
+	}
+	return nil
+}
+
+func bodyError(key string) rapidval.ValidationErrors {
+	return rapidval.ValidationErrors{{
+		MessageKey:    key,
+		MessageParams: map[string]interface{}{},
+	}}
+}
+
+// appendPrefixed appends src to dst, prefixing every field with prefix.
+// Errors without a field are reported on the prefix itself, e.g. "body".
+func appendPrefixed(dst rapidval.ValidationErrors, prefix string, src rapidval.ValidationErrors) rapidval.ValidationErrors {
+	for _, err := range src {
+		if err.Field == "" {
+			err.Field = prefix[:len(prefix)-1]
+		} else {
+			err.Field = prefix + err.Field
+		}
+		if err.MessageParams == nil {
+			err.MessageParams = map[string]interface{}{}
+		}
+		err.MessageParams[rapidval.Field] = err.Field
+		dst = append(dst, err)
+	}
+	return dst
+}
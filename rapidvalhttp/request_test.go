@@ -0,0 +1,179 @@
+package rapidvalhttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+	"github.com/9ssi7/rapidval/schema"
+)
+
+type createOrder struct {
+	SKU      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
+
+func (o *createOrder) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.Required("SKU", o.SKU),
+		rapidval.Between("Quantity", o.Quantity, 1, 10),
+	}
+}
+
+func errorKeys(t *testing.T, err error) map[string]string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	verr, ok := err.(rapidval.ValidationErrors)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	keys := make(map[string]string, len(verr))
+	for _, e := range verr {
+		keys[e.Field] = e.MessageKey
+	}
+	return keys
+}
+
+func TestValidateRequest(t *testing.T) {
+	spec := func() RequestSpec {
+		return RequestSpec{
+			Headers: schema.Values(map[string]*schema.ParamSchema{
+				"X-Tenant-Id": schema.StringParam(nil).Required(),
+			}),
+			Query: schema.Values(map[string]*schema.ParamSchema{
+				"dry_run": schema.BoolParam(),
+			}),
+			Path: schema.Values(map[string]*schema.ParamSchema{
+				"store": schema.IntParam(schema.Number().Min(1)).Required(),
+			}),
+			PathValue: func(r *http.Request, name string) string {
+				return strings.TrimPrefix(r.URL.Path, "/stores/")
+			},
+			Body: &createOrder{},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		target string
+		header string
+		body   string
+		want   map[string]string
+	}{
+		{
+			name:   "valid request",
+			target: "/stores/3?dry_run=true",
+			header: "tenant-1",
+			body:   `{"sku":"A1","quantity":2}`,
+		},
+		{
+			name:   "invalid request",
+			target: "/stores/0?dry_run=maybe",
+			body:   `{"sku":"","quantity":20}`,
+			want: map[string]string{
+				"header.X-Tenant-Id": rapidval.MsgRequired,
+				"query.dry_run":      rapidval.MsgInvalidType,
+				"path.store":         rapidval.MsgMin,
+				"body.SKU":           rapidval.MsgRequired,
+				"body.Quantity":      rapidval.MsgBetween,
+			},
+		},
+		{
+			name:   "malformed body",
+			target: "/stores/3",
+			header: "tenant-1",
+			body:   `{"sku":`,
+			want:   map[string]string{"body": rapidval.MsgInvalidJSON},
+		},
+		{
+			name:   "empty body",
+			target: "/stores/3",
+			header: "tenant-1",
+			want:   map[string]string{"body": rapidval.MsgRequired},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			if tt.header != "" {
+				r.Header.Set("x-tenant-id", tt.header)
+			}
+			got := errorKeys(t, ValidateRequest(r, spec()))
+			if len(got) != len(tt.want) {
+				t.Errorf("got errors %v, want %v", got, tt.want)
+			}
+			for field, key := range tt.want {
+				if got[field] != key {
+					t.Errorf("field %s: got %v, want %v", field, got[field], key)
+				}
+			}
+		})
+	}
+}
+
+type soldOutKey struct{}
+
+type stockCheck struct {
+	SKU string `json:"sku"`
+}
+
+func (c *stockCheck) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.RuleFunc(func(ctx context.Context) *rapidval.ValidationError {
+			if soldOut, _ := ctx.Value(soldOutKey{}).(string); soldOut == c.SKU {
+				return &rapidval.ValidationError{Field: "SKU", MessageKey: rapidval.MsgUnavailable}
+			}
+			return nil
+		}),
+	}
+}
+
+func TestValidateRequestContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), soldOutKey{}, "A1")
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"sku":"A1"}`)).WithContext(ctx)
+
+	got := errorKeys(t, ValidateRequest(r, RequestSpec{Body: &stockCheck{}}))
+	if len(got) != 1 || got["body.SKU"] != rapidval.MsgUnavailable {
+		t.Errorf("got errors %v, want body.SKU %s", got, rapidval.MsgUnavailable)
+	}
+}
+
+func TestValidateRequestBodySchema(t *testing.T) {
+	mux := http.NewServeMux()
+	var err error
+	mux.HandleFunc("POST /stores/{store}/orders", func(w http.ResponseWriter, r *http.Request) {
+		err = ValidateRequest(r, RequestSpec{
+			Path: schema.Values(map[string]*schema.ParamSchema{
+				"store": schema.IntParam(nil).Required(),
+			}),
+			BodySchema: schema.Object(map[string]schema.Node{
+				"items": schema.Array(schema.Object(map[string]schema.Node{
+					"price": schema.Number().Min(0),
+				})).Required(),
+			}),
+		})
+	})
+
+	r := httptest.NewRequest(http.MethodPost, "/stores/abc/orders", strings.NewReader(`{"items":[{"price":1},{"price":-1}]}`))
+	mux.ServeHTTP(httptest.NewRecorder(), r)
+
+	got := errorKeys(t, err)
+	want := map[string]string{
+		"path.store":          rapidval.MsgInvalidType,
+		"body.items[1].price": rapidval.MsgMin,
+	}
+	if len(got) != len(want) {
+		t.Errorf("got errors %v, want %v", got, want)
+	}
+	for field, key := range want {
+		if got[field] != key {
+			t.Errorf("field %s: got %v, want %v", field, got[field], key)
+		}
+	}
+}
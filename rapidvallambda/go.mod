@@ -0,0 +1,10 @@
+module github.com/9ssi7/rapidval/rapidvallambda
+
+go 1.23.0
+
+require (
+	github.com/9ssi7/rapidval v0.0.0
+	github.com/aws/aws-lambda-go v1.47.0
+)
+
+replace github.com/9ssi7/rapidval => ../
@@ -0,0 +1,138 @@
+// Package rapidvallambda provides helpers for validating API Gateway proxy requests in AWS Lambda handlers.
+//
+// Basic usage:
+//
+//	func handler(ctx context.Context, req events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+//	    var in CreateUserInput
+//	    if res, ok := rapidvallambda.BindContext(ctx, v, req, &in, tr); !ok {
+//	        return res, nil
+//	    }
+//	    ...
+//	}
+package rapidvallambda
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/9ssi7/rapidval"
+	"github.com/9ssi7/rapidval/rapidvalhttp"
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// ErrorBody is the JSON body of the responses built by this package.
+type ErrorBody struct {
+	Errors []FieldError `json:"errors"`
+}
+
+// FieldError is a single serialized validation error.
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+// Bind unmarshals the request body into dst and validates it.
+// It returns false and a ready-to-send response when the body is missing or malformed (400) or fails
+// validation (422). Like rapidvalhttp, a missing body is reported as MsgRequired and a malformed one
+// as MsgInvalidJSON, without a field.
+// The translator is used to render messages; if it is nil, message keys are used instead.
+func Bind(req events.APIGatewayProxyRequest, dst rapidval.Validateable, tr *rapidval.Translator) (events.APIGatewayProxyResponse, bool) {
+	return BindContext(context.Background(), nil, req, dst, tr)
+}
+
+// BindContext is like Bind but validates dst with v under ctx, the context of the Lambda invocation.
+// A nil v validates with the default settings.
+func BindContext(ctx context.Context, v *rapidval.Validator, req events.APIGatewayProxyRequest, dst rapidval.Validateable, tr *rapidval.Translator) (events.APIGatewayProxyResponse, bool) {
+	if req.Body == "" {
+		return invalidBody(rapidval.MsgRequired, tr), false
+	}
+	body := []byte(req.Body)
+	if req.IsBase64Encoded {
+		decoded, err := base64.StdEncoding.DecodeString(req.Body)
+		if err != nil {
+			return invalidBody(rapidval.MsgInvalidJSON, tr), false
+		}
+		body = decoded
+	}
+
+	if err := json.Unmarshal(body, dst); err != nil {
+		return invalidBody(rapidval.MsgInvalidJSON, tr), false
+	}
+
+	if v == nil {
+		v = rapidval.New()
+	}
+	if err := v.ValidateContext(ctx, dst); err != nil {
+		return ErrorResponse(err, tr), false
+	}
+	return events.APIGatewayProxyResponse{}, true
+}
+
+// ErrorResponse builds an error response from a validation error, with the status of
+// rapidval.DefaultStatusMap: 422 Unprocessable Entity for input errors, and e.g. 403 for MsgForbidden.
+// A single *rapidval.ValidationError is reported like ValidationErrors with one error.
+// Errors that are not rapidval validation errors produce a 500 response without details.
+func ErrorResponse(err error, tr *rapidval.Translator) events.APIGatewayProxyResponse {
+	return ErrorResponseWithStatuses(err, tr, defaultStatuses)
+}
+
+// ErrorResponseWithStatuses is like ErrorResponse but takes the response status from statuses.
+func ErrorResponseWithStatuses(err error, tr *rapidval.Translator, statuses rapidval.StatusMap) events.APIGatewayProxyResponse {
+	var verrs rapidval.ValidationErrors
+	if !errors.As(err, &verrs) {
+		var verr *rapidval.ValidationError
+		if !errors.As(err, &verr) {
+			return response(http.StatusInternalServerError, ErrorBody{Errors: []FieldError{}})
+		}
+		verrs = rapidval.ValidationErrors{verr}
+	}
+	return response(statuses.Status(verrs), errorBody(verrs, tr))
+}
+
+func errorBody(verrs rapidval.ValidationErrors, tr *rapidval.Translator) ErrorBody {
+	body := ErrorBody{Errors: make([]FieldError, 0, len(verrs))}
+	for _, ve := range verrs {
+		msg := ve.MessageKey
+		if tr != nil {
+			msg = tr.Translate(ve)
+		}
+		body.Errors = append(body.Errors, FieldError{
+			Field:   ve.Field,
+			Key:     ve.MessageKey,
+			Message: msg,
+		})
+	}
+	return body
+}
+
+var defaultStatuses = rapidval.DefaultStatusMap()
+
+// EnvelopeResponse builds an error response whose status, body and content type are described by env,
+// for APIs with a fixed error contract such as RFC 9457 problem details. The correlation ID, if
+// env includes one, is read from ctx, e.g. one stored with rapidval.WithCorrelationID(ctx, req.RequestContext.RequestID).
+func EnvelopeResponse(ctx context.Context, err error, tr *rapidval.Translator, env rapidvalhttp.Envelope) events.APIGatewayProxyResponse {
+	status, body := env.Body(ctx, err, tr)
+	res := response(status, body)
+	if env.ContentType != "" {
+		res.Headers["Content-Type"] = env.ContentType
+	}
+	return res
+}
+
+func invalidBody(key string, tr *rapidval.Translator) events.APIGatewayProxyResponse {
+	verrs := rapidval.ValidationErrors{{MessageKey: key, MessageParams: map[string]interface{}{}}}
+	return response(http.StatusBadRequest, errorBody(verrs, tr))
+}
+
+func response(status int, body interface{}) events.APIGatewayProxyResponse {
+	b, _ := json.Marshal(body)
+	return events.APIGatewayProxyResponse{
+		StatusCode: status,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		Body:       string(b),
+	}
+}
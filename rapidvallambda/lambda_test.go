@@ -0,0 +1,193 @@
+package rapidvallambda
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+	"github.com/9ssi7/rapidval/rapidvalhttp"
+	"github.com/aws/aws-lambda-go/events"
+)
+
+type createUserInput struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func (i *createUserInput) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.Required("Name", i.Name),
+		rapidval.Email("Email", i.Email),
+	}
+}
+
+func TestBind(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        events.APIGatewayProxyRequest
+		wantOK     bool
+		wantStatus int
+		wantErrs   int
+		wantKey    string
+	}{
+		{
+			name:   "valid body",
+			req:    events.APIGatewayProxyRequest{Body: `{"name":"John","email":"john@example.com"}`},
+			wantOK: true,
+		},
+		{
+			name: "valid base64 body",
+			req: events.APIGatewayProxyRequest{
+				Body:            base64.StdEncoding.EncodeToString([]byte(`{"name":"John","email":"john@example.com"}`)),
+				IsBase64Encoded: true,
+			},
+			wantOK: true,
+		},
+		{
+			name:       "invalid body",
+			req:        events.APIGatewayProxyRequest{Body: `{"name":""}`},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErrs:   2,
+		},
+		{
+			name:       "malformed body",
+			req:        events.APIGatewayProxyRequest{Body: `{`},
+			wantStatus: http.StatusBadRequest,
+			wantErrs:   1,
+			wantKey:    rapidval.MsgInvalidJSON,
+		},
+		{
+			name:       "missing body",
+			req:        events.APIGatewayProxyRequest{},
+			wantStatus: http.StatusBadRequest,
+			wantErrs:   1,
+			wantKey:    rapidval.MsgRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var in createUserInput
+			res, ok := Bind(tt.req, &in, nil)
+			if ok != tt.wantOK {
+				t.Fatalf("Bind() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok {
+				return
+			}
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+
+			var body ErrorBody
+			if err := json.Unmarshal([]byte(res.Body), &body); err != nil {
+				t.Fatalf("response body is not valid JSON: %v", err)
+			}
+			if len(body.Errors) != tt.wantErrs {
+				t.Errorf("got %d errors, want %d", len(body.Errors), tt.wantErrs)
+			}
+			if tt.wantKey != "" && body.Errors[0].Key != tt.wantKey {
+				t.Errorf("Key = %v, want %v", body.Errors[0].Key, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestErrorResponseTranslated(t *testing.T) {
+	err := rapidval.ValidationErrors{rapidval.Required("Name", "")}
+	res := ErrorResponse(err, rapidval.NewTranslator())
+
+	var body ErrorBody
+	if err := json.Unmarshal([]byte(res.Body), &body); err != nil {
+		t.Fatalf("response body is not valid JSON: %v", err)
+	}
+	if want := "Name alanı zorunludur"; body.Errors[0].Message != want {
+		t.Errorf("Message = %v, want %v", body.Errors[0].Message, want)
+	}
+}
+
+func TestErrorResponseStatus(t *testing.T) {
+	forbidden := &rapidval.ValidationError{Field: "ProjectID", MessageKey: rapidval.MsgForbidden}
+	required := rapidval.Required("Name", "")
+
+	tests := []struct {
+		name     string
+		statuses rapidval.StatusMap
+		err      error
+		want     int
+	}{
+		{"input error", rapidval.DefaultStatusMap(), rapidval.ValidationErrors{required}, http.StatusUnprocessableEntity},
+		{"forbidden", rapidval.DefaultStatusMap(), rapidval.ValidationErrors{required, forbidden}, http.StatusForbidden},
+		{"custom", rapidval.StatusMap{rapidval.MsgRequired: http.StatusBadRequest}, rapidval.ValidationErrors{required}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := ErrorResponseWithStatuses(tt.err, nil, tt.statuses); res.StatusCode != tt.want {
+				t.Errorf("StatusCode = %d, want %d", res.StatusCode, tt.want)
+			}
+		})
+	}
+	if res := ErrorResponse(rapidval.ValidationErrors{forbidden}, nil); res.StatusCode != http.StatusForbidden {
+		t.Errorf("ErrorResponse() StatusCode = %d, want %d", res.StatusCode, http.StatusForbidden)
+	}
+	if res := ErrorResponse(forbidden, nil); res.StatusCode != http.StatusForbidden || !strings.Contains(res.Body, `"field":"ProjectID"`) {
+		t.Errorf("ErrorResponse() of a single error = %d %s, want %d", res.StatusCode, res.Body, http.StatusForbidden)
+	}
+}
+
+type reservedKey struct{}
+
+type reserveInput struct {
+	Seat string `json:"seat"`
+}
+
+func (i *reserveInput) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.RuleFunc(func(ctx context.Context) *rapidval.ValidationError {
+			if reserved, _ := ctx.Value(reservedKey{}).(string); reserved == i.Seat {
+				return &rapidval.ValidationError{Field: "Seat", MessageKey: rapidval.MsgUnavailable}
+			}
+			return nil
+		}),
+	}
+}
+
+func TestBindContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), reservedKey{}, "12A")
+	v := rapidval.New(rapidval.WithKeyPrefix("seats."))
+	var in reserveInput
+	res, ok := BindContext(ctx, v, events.APIGatewayProxyRequest{Body: `{"seat":"12A"}`}, &in, nil)
+	if ok {
+		t.Fatal("BindContext() ok = true, want false")
+	}
+	var body ErrorBody
+	if err := json.Unmarshal([]byte(res.Body), &body); err != nil {
+		t.Fatalf("response body is not valid JSON: %v", err)
+	}
+	if len(body.Errors) != 1 || body.Errors[0].Key != "seats."+rapidval.MsgUnavailable {
+		t.Errorf("errors = %+v, want seats.%s", body.Errors, rapidval.MsgUnavailable)
+	}
+}
+
+func TestEnvelopeResponse(t *testing.T) {
+	err := rapidval.ValidationErrors{rapidval.Required("Name", "")}
+	env := rapidvalhttp.ProblemDetails("Validation failed")
+	env.CorrelationIDKey = "requestId"
+	ctx := rapidval.WithCorrelationID(context.Background(), "c6af9ac6")
+	res := EnvelopeResponse(ctx, err, nil, env)
+
+	if res.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("StatusCode = %d, want %d", res.StatusCode, http.StatusUnprocessableEntity)
+	}
+	if ct := res.Headers["Content-Type"]; ct != "application/problem+json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	want := `{"errors":[{"field":"Name","key":"validation.required","message":"validation.required"}],"requestId":"c6af9ac6","status":422,"title":"Validation failed","type":"about:blank"}`
+	if res.Body != want {
+		t.Errorf("Body = %s\nwant   %s", res.Body, want)
+	}
+}
@@ -0,0 +1,102 @@
+// Package rapidvalmq validates event payloads in message consumers (Kafka, NATS, SQS, ...).
+//
+//	handle := rapidvalmq.Consume(func(ctx context.Context, e *OrderCreated) error {
+//	    return orders.Create(ctx, e)
+//	}, rapidvalmq.WithDeadLetter(func(ctx context.Context, payload []byte, err error) error {
+//	    return dlq.Publish(ctx, payload, err.Error())
+//	}))
+//
+//	for msg := range messages {
+//	    if err := handle(ctx, msg.Value); err != nil {
+//	        msg.Nack()
+//	    }
+//	}
+package rapidvalmq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// DeadLetterFunc receives payloads that could not be decoded or failed validation, along with the reason.
+// If it returns nil the message is considered handled.
+type DeadLetterFunc func(ctx context.Context, payload []byte, err error) error
+
+// Option configures Consume.
+type Option func(*config)
+
+type config struct {
+	decode     func([]byte, interface{}) error
+	deadLetter DeadLetterFunc
+	validator  *rapidval.Validator
+}
+
+// WithDeadLetter routes invalid payloads to fn instead of returning an error to the consumer loop.
+func WithDeadLetter(fn DeadLetterFunc) Option {
+	return func(c *config) {
+		c.deadLetter = fn
+	}
+}
+
+// WithDecoder replaces json.Unmarshal, e.g. to decode protobuf or Avro payloads.
+func WithDecoder(decode func(data []byte, v interface{}) error) Option {
+	return func(c *config) {
+		c.decode = decode
+	}
+}
+
+// WithValidator validates payloads with v, e.g. one created with rapidval.WithTimeout, instead of rapidval.New().
+func WithValidator(v *rapidval.Validator) Option {
+	return func(c *config) {
+		c.validator = v
+	}
+}
+
+// DecodeError is passed to the dead-letter callback (or returned) when a payload cannot be decoded.
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("rapidvalmq: decode payload: %v", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Consume wraps handler so that raw payloads are decoded into a new T, validated, and only then handled.
+//
+// Payloads are validated under the context passed to the returned function.
+// Payloads that cannot be decoded produce a *DecodeError and invalid payloads produce rapidval.ValidationErrors.
+// Both are passed to the dead-letter callback when configured; otherwise they are returned to the caller.
+func Consume[T any, PT interface {
+	*T
+	rapidval.Validateable
+}](handler func(context.Context, PT) error, opts ...Option) func(context.Context, []byte) error {
+	cfg := config{decode: json.Unmarshal, validator: rapidval.New()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	reject := func(ctx context.Context, payload []byte, err error) error {
+		if cfg.deadLetter == nil {
+			return err
+		}
+		return cfg.deadLetter(ctx, payload, err)
+	}
+
+	return func(ctx context.Context, payload []byte) error {
+		event := PT(new(T))
+		if err := cfg.decode(payload, event); err != nil {
+			return reject(ctx, payload, &DecodeError{Err: err})
+		}
+		if err := cfg.validator.ValidateContext(ctx, event); err != nil {
+			return reject(ctx, payload, err)
+		}
+		return handler(ctx, event)
+	}
+}
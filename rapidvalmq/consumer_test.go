@@ -0,0 +1,126 @@
+package rapidvalmq
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+type orderCreated struct {
+	ID    string `json:"id"`
+	Total int    `json:"total"`
+}
+
+func (e *orderCreated) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.Required("ID", e.ID),
+		rapidval.Between("Total", e.Total, 1, 1000),
+	}
+}
+
+func TestConsume(t *testing.T) {
+	var handled *orderCreated
+	handler := func(ctx context.Context, e *orderCreated) error {
+		handled = e
+		return nil
+	}
+
+	t.Run("valid payload", func(t *testing.T) {
+		handled = nil
+		err := Consume(handler)(context.Background(), []byte(`{"id":"o-1","total":10}`))
+		if err != nil {
+			t.Fatalf("Consume() error = %v", err)
+		}
+		if handled == nil || handled.ID != "o-1" {
+			t.Errorf("handler received %+v", handled)
+		}
+	})
+
+	t.Run("invalid payload without dead letter", func(t *testing.T) {
+		handled = nil
+		err := Consume(handler)(context.Background(), []byte(`{"total":0}`))
+		if verr, ok := err.(rapidval.ValidationErrors); !ok || len(verr) != 2 {
+			t.Errorf("Consume() error = %v, want 2 validation errors", err)
+		}
+		if handled != nil {
+			t.Error("handler should not be called for invalid payloads")
+		}
+	})
+
+	t.Run("dead letter", func(t *testing.T) {
+		var reasons []error
+		consume := Consume(handler, WithDeadLetter(func(ctx context.Context, payload []byte, err error) error {
+			reasons = append(reasons, err)
+			return nil
+		}))
+
+		if err := consume(context.Background(), []byte(`{"id":""}`)); err != nil {
+			t.Errorf("Consume() error = %v, want nil after dead letter", err)
+		}
+		if err := consume(context.Background(), []byte(`{`)); err != nil {
+			t.Errorf("Consume() error = %v, want nil after dead letter", err)
+		}
+
+		if len(reasons) != 2 {
+			t.Fatalf("dead letter called %d times, want 2", len(reasons))
+		}
+		if _, ok := reasons[0].(rapidval.ValidationErrors); !ok {
+			t.Errorf("first reason = %T, want ValidationErrors", reasons[0])
+		}
+		var decodeErr *DecodeError
+		if !errors.As(reasons[1], &decodeErr) {
+			t.Errorf("second reason = %T, want *DecodeError", reasons[1])
+		}
+	})
+
+	t.Run("custom decoder", func(t *testing.T) {
+		decode := func(data []byte, v interface{}) error {
+			v.(*orderCreated).ID = string(data)
+			v.(*orderCreated).Total = 1
+			return nil
+		}
+		handled = nil
+		if err := Consume(handler, WithDecoder(decode))(context.Background(), []byte("o-2")); err != nil {
+			t.Fatalf("Consume() error = %v", err)
+		}
+		if handled == nil || handled.ID != "o-2" {
+			t.Errorf("handler received %+v", handled)
+		}
+	})
+}
+
+type duplicateKey struct{}
+
+type paymentReceived struct {
+	ID string `json:"id"`
+}
+
+func (e *paymentReceived) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.RuleFunc(func(ctx context.Context) *rapidval.ValidationError {
+			if seen, _ := ctx.Value(duplicateKey{}).(string); seen == e.ID {
+				return &rapidval.ValidationError{Field: "ID", MessageKey: rapidval.MsgUnavailable}
+			}
+			return nil
+		}),
+	}
+}
+
+func TestConsumeContext(t *testing.T) {
+	handler := func(ctx context.Context, e *paymentReceived) error { return nil }
+	ctx := context.WithValue(context.Background(), duplicateKey{}, "p-1")
+
+	var verrs rapidval.ValidationErrors
+	err := Consume(handler)(ctx, []byte(`{"id":"p-1"}`))
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].MessageKey != rapidval.MsgUnavailable {
+		t.Errorf("Consume() error = %v, want %s", err, rapidval.MsgUnavailable)
+	}
+
+	v := rapidval.New(rapidval.WithKeyPrefix("payments."))
+	err = Consume(handler, WithValidator(v))(ctx, []byte(`{"id":"p-1"}`))
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].MessageKey != "payments."+rapidval.MsgUnavailable {
+		t.Errorf("Consume() error = %v, want payments.%s", err, rapidval.MsgUnavailable)
+	}
+}
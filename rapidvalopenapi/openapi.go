@@ -0,0 +1,106 @@
+// Package rapidvalopenapi generates OpenAPI 3 components describing the validation error
+// responses produced by rapidval integrations, so API documentation matches actual behavior.
+//
+// The documented envelope is the one returned by rapidvallambda and rapidvalwasm:
+//
+//	{"errors": [{"field": "Email", "key": "validation.email", "message": "..."}]}
+//
+// Typical usage is to merge the components into an existing document, or to write them out
+// during the build:
+//
+//	rapidvalopenapi.WriteComponents(os.Stdout, "validation.unique")
+//
+// and to reference the response from operations as "#/components/responses/ValidationFailed".
+package rapidvalopenapi
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// Component names used in the generated document.
+const (
+	FieldErrorSchema = "ValidationFieldError"
+	ErrorBodySchema  = "ValidationErrorBody"
+	FailedResponse   = "ValidationFailed"
+)
+
+// Components returns an OpenAPI 3 components object with the error envelope schemas and a 422 response.
+// The "key" property enumerates the message keys of the built-in rules together with extraKeys,
+// which should list the keys of custom rules, e.g. "validation.vat_registered".
+func Components(extraKeys ...string) map[string]interface{} {
+	return map[string]interface{}{
+		"schemas": map[string]interface{}{
+			FieldErrorSchema: map[string]interface{}{
+				"type":     "object",
+				"required": []string{"key", "message"},
+				"properties": map[string]interface{}{
+					"field": map[string]interface{}{
+						"type":        "string",
+						"description": "Name of the invalid field. Runtime schemas report JSON Pointers, e.g. /items/2/price. Omitted for errors that concern the whole request.",
+					},
+					"key": map[string]interface{}{
+						"type":        "string",
+						"description": "Message key identifying the failed rule.",
+						"enum":        keys(extraKeys),
+					},
+					"message": map[string]interface{}{
+						"type":        "string",
+						"description": "Translated message, or the message key if no translation is configured.",
+					},
+				},
+			},
+			ErrorBodySchema: map[string]interface{}{
+				"type":     "object",
+				"required": []string{"errors"},
+				"properties": map[string]interface{}{
+					"errors": map[string]interface{}{
+						"type":  "array",
+						"items": ref("schemas", FieldErrorSchema),
+					},
+				},
+			},
+		},
+		"responses": map[string]interface{}{
+			FailedResponse: map[string]interface{}{
+				"description": "The request failed validation.",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": ref("schemas", ErrorBodySchema),
+					},
+				},
+			},
+		},
+	}
+}
+
+// WriteComponents writes the result of Components as indented JSON to w.
+func WriteComponents(w io.Writer, extraKeys ...string) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]interface{}{"components": Components(extraKeys...)})
+}
+
+func ref(kind, name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/" + kind + "/" + name}
+}
+
+// keys merges the built-in message keys with extra, removing duplicates.
+func keys(extra []string) []string {
+	all := rapidval.MessageKeys()
+	seen := make(map[string]bool, len(all)+len(extra))
+	for _, k := range all {
+		seen[k] = true
+	}
+	for _, k := range extra {
+		if !seen[k] {
+			seen[k] = true
+			all = append(all, k)
+		}
+	}
+	sort.Strings(all)
+	return all
+}
@@ -0,0 +1,62 @@
+package rapidvalopenapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+func TestWriteComponents(t *testing.T) {
+	var b strings.Builder
+	if err := WriteComponents(&b, "validation.unique", rapidval.MsgRequired); err != nil {
+		t.Fatalf("WriteComponents() error = %v", err)
+	}
+
+	var doc struct {
+		Components struct {
+			Schemas map[string]struct {
+				Required   []string `json:"required"`
+				Properties map[string]struct {
+					Enum  []string          `json:"enum"`
+					Items map[string]string `json:"items"`
+				} `json:"properties"`
+			} `json:"schemas"`
+			Responses map[string]struct {
+				Content map[string]struct {
+					Schema map[string]string `json:"schema"`
+				} `json:"content"`
+			} `json:"responses"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal([]byte(b.String()), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+
+	enum := doc.Components.Schemas[FieldErrorSchema].Properties["key"].Enum
+	if len(enum) != len(rapidval.MessageKeys())+1 {
+		t.Errorf("key enum has %d values, want %d: %v", len(enum), len(rapidval.MessageKeys())+1, enum)
+	}
+	if !contains(enum, "validation.unique") || !contains(enum, rapidval.MsgInternal) {
+		t.Errorf("key enum = %v, want built-in and extra keys", enum)
+	}
+
+	items := doc.Components.Schemas[ErrorBodySchema].Properties["errors"].Items["$ref"]
+	if items != "#/components/schemas/"+FieldErrorSchema {
+		t.Errorf("errors items $ref = %q", items)
+	}
+	schema := doc.Components.Responses[FailedResponse].Content["application/json"].Schema["$ref"]
+	if schema != "#/components/schemas/"+ErrorBodySchema {
+		t.Errorf("response schema $ref = %q", schema)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+// Package rapidvalozzo adapts ozzo-validation rules to rapidval and vice versa.
+//
+// The adapter relies only on the shape of ozzo's interfaces, so it adds no dependency:
+//
+//	// ozzo rule inside rapidval
+//	rapidvalozzo.FromRule("Code", u.Code, is.Alphanumeric)
+//
+//	// rapidval rule inside ozzo
+//	validation.Field(&u.Email, rapidvalozzo.AsRule(rapidval.Email))
+package rapidvalozzo
+
+import (
+	"fmt"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// MsgInvalid is the message key used for ozzo errors without a known code.
+const MsgInvalid = "validation.invalid"
+
+// Rule is implemented by ozzo-validation rules (validation.Rule).
+type Rule interface {
+	Validate(value interface{}) error
+}
+
+// KeyMap maps ozzo-validation error codes to rapidval message keys.
+// Codes not present in the map are used as message keys as they are.
+var KeyMap = map[string]string{
+	"validation_required":                        rapidval.MsgRequired,
+	"validation_nil_or_not_empty":                rapidval.MsgRequired,
+	"validation_is_email":                        rapidval.MsgInvalidEmail,
+	"validation_is_email_format":                 rapidval.MsgInvalidEmail,
+	"validation_length_too_short":                rapidval.MsgMinLength,
+	"validation_length_too_long":                 rapidval.MsgMaxLength,
+	"validation_length_out_of_range":             rapidval.MsgBetween,
+	"validation_min_greater_equal_than_required": rapidval.MsgMin,
+	"validation_max_less_equal_than_required":    rapidval.MsgMax,
+	"validation_in_invalid":                      rapidval.MsgOneOf,
+}
+
+// ozzoError is implemented by ozzo-validation errors (validation.Error).
+type ozzoError interface {
+	error
+	Code() string
+	Message() string
+	Params() map[string]interface{}
+}
+
+// internalError is implemented by ozzo-validation internal errors (validation.InternalError).
+type internalError interface {
+	error
+	InternalError() error
+}
+
+// FromRule runs an ozzo-validation rule against value and converts its failure into a ValidationError.
+// Known ozzo error codes are mapped through KeyMap and ozzo's params are copied into MessageParams.
+func FromRule(field string, value interface{}, rule Rule) *rapidval.ValidationError {
+	err := rule.Validate(value)
+	if err == nil {
+		return nil
+	}
+
+	params := map[string]interface{}{
+		rapidval.Field: field,
+		rapidval.Value: value,
+	}
+	key := MsgInvalid
+	if oe, ok := err.(ozzoError); ok {
+		key = oe.Code()
+		if mapped, ok := KeyMap[key]; ok {
+			key = mapped
+		}
+		for k, v := range oe.Params() {
+			params[k] = v
+		}
+		params["Message"] = oe.Message()
+	} else {
+		params["Message"] = err.Error()
+	}
+
+	return &rapidval.ValidationError{
+		Field:         field,
+		MessageKey:    key,
+		MessageParams: params,
+		CurrentValue:  value,
+	}
+}
+
+// AsRule adapts a rapidval rule to ozzo-validation's Rule interface.
+// Field names are assigned by ozzo, so the rule is called with an empty field name.
+// Nil values are skipped, following ozzo's convention that only Required rejects them;
+// values of another type than T produce an ozzo internal error.
+func AsRule[T any](rule func(field string, value T) *rapidval.ValidationError) Rule {
+	return ruleFunc[T](rule)
+}
+
+type ruleFunc[T any] func(field string, value T) *rapidval.ValidationError
+
+func (f ruleFunc[T]) Validate(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	v, ok := value.(T)
+	if !ok {
+		if p, isPtr := value.(*T); isPtr {
+			if p == nil {
+				return nil
+			}
+			v = *p
+		} else {
+			var zero T
+			return typeError{fmt.Errorf("rapidvalozzo: cannot validate %T as %T", value, zero)}
+		}
+	}
+	if err := f("", v); err != nil {
+		return err
+	}
+	return nil
+}
+
+type typeError struct {
+	err error
+}
+
+func (e typeError) Error() string        { return e.err.Error() }
+func (e typeError) InternalError() error { return e.err }
+
+var _ internalError = typeError{}
@@ -0,0 +1,77 @@
+package rapidvalozzo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// ozzoRequired mimics ozzo-validation's Required rule and error type.
+type ozzoRequired struct{}
+
+func (ozzoRequired) Validate(value interface{}) error {
+	if s, _ := value.(string); s == "" {
+		return fakeOzzoError{code: "validation_required", message: "cannot be blank"}
+	}
+	return nil
+}
+
+type fakeOzzoError struct {
+	code    string
+	message string
+}
+
+func (e fakeOzzoError) Error() string                  { return e.message }
+func (e fakeOzzoError) Code() string                   { return e.code }
+func (e fakeOzzoError) Message() string                { return e.message }
+func (e fakeOzzoError) Params() map[string]interface{} { return map[string]interface{}{"Extra": 1} }
+
+type plainRule struct{}
+
+func (plainRule) Validate(value interface{}) error { return errors.New("bad value") }
+
+func TestFromRule(t *testing.T) {
+	if err := FromRule("Name", "John", ozzoRequired{}); err != nil {
+		t.Errorf("FromRule() = %v, want nil", err)
+	}
+
+	err := FromRule("Name", "", ozzoRequired{})
+	if err == nil {
+		t.Fatal("FromRule() should return an error")
+	}
+	if err.MessageKey != rapidval.MsgRequired {
+		t.Errorf("MessageKey = %v, want %v", err.MessageKey, rapidval.MsgRequired)
+	}
+	if err.Field != "Name" || err.MessageParams["Extra"] != 1 || err.MessageParams["Message"] != "cannot be blank" {
+		t.Errorf("unexpected error: %+v", err)
+	}
+
+	err = FromRule("Code", "x", plainRule{})
+	if err == nil || err.MessageKey != MsgInvalid || err.MessageParams["Message"] != "bad value" {
+		t.Errorf("unexpected error for plain rule: %+v", err)
+	}
+}
+
+func TestAsRule(t *testing.T) {
+	rule := AsRule(rapidval.Email)
+
+	if err := rule.Validate("john@example.com"); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	if err := rule.Validate(nil); err != nil {
+		t.Errorf("Validate(nil) = %v, want nil", err)
+	}
+
+	s := "invalid"
+	err := rule.Validate(&s)
+	var ve *rapidval.ValidationError
+	if !errors.As(err, &ve) || ve.MessageKey != rapidval.MsgInvalidEmail {
+		t.Errorf("Validate() = %v, want %v", err, rapidval.MsgInvalidEmail)
+	}
+
+	err = rule.Validate(42)
+	if _, ok := err.(internalError); !ok {
+		t.Errorf("Validate() with wrong type = %T, want internal error", err)
+	}
+}
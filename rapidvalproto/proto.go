@@ -0,0 +1,163 @@
+// Package rapidvalproto provides validation helpers for protobuf-generated Go types.
+//
+// It relies only on the shape of generated code (getters, wrapper types and protobuf struct tags),
+// so it adds no dependency on google.golang.org/protobuf:
+//
+//	func (x *CreateUserRequest) Validations() rapidval.P {
+//	    return rapidval.P{
+//	        rapidvalproto.Present("Nickname", x.Nickname != nil),
+//	        rapidvalproto.RequiredWrapper("DisplayName", x.DisplayName),
+//	        rapidvalproto.Wrapped("DisplayName", x.DisplayName, func(f string, v string) *rapidval.ValidationError {
+//	            return rapidval.MaxLength(f, v, 50)
+//	        }),
+//	    }
+//	}
+//
+//	err = rapidvalproto.FieldNames(req, rapidval.New().Validate(req)) // "DisplayName" -> "display_name"
+package rapidvalproto
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// Present is the Required counterpart for fields with explicit presence: proto3 optional fields,
+// proto2 fields, wrapper types and message fields. Unlike Required, a present field holding its
+// zero value ("" or 0) is valid. Pass the result of the generated Has* method or a nil check.
+func Present(field string, has bool) *rapidval.ValidationError {
+	if has {
+		return nil
+	}
+	return &rapidval.ValidationError{
+		Field:      field,
+		MessageKey: rapidval.MsgRequired,
+		MessageParams: map[string]interface{}{
+			rapidval.Field: field,
+			rapidval.Value: nil,
+		},
+	}
+}
+
+// Unwrap returns the value held by a wrapperspb message (StringValue, Int64Value, BoolValue, ...)
+// and whether the wrapper is set.
+func Unwrap[W interface {
+	*E
+	GetValue() T
+}, E any, T any](w W) (T, bool) {
+	if w == nil {
+		var zero T
+		return zero, false
+	}
+	return w.GetValue(), true
+}
+
+// RequiredWrapper reports a required error when a wrapperspb field is not set.
+// A set wrapper holding the zero value is valid.
+func RequiredWrapper[W interface {
+	*E
+	GetValue() T
+}, E any, T any](field string, w W) *rapidval.ValidationError {
+	return Present(field, w != nil)
+}
+
+// Wrapped applies rule to the value held by a wrapperspb field when it is set.
+// Unset wrappers are skipped; combine with RequiredWrapper to reject them.
+func Wrapped[W interface {
+	*E
+	GetValue() T
+}, E any, T any](field string, w W, rule func(field string, value T) *rapidval.ValidationError) *rapidval.ValidationError {
+	v, ok := Unwrap(w)
+	if !ok {
+		return nil
+	}
+	return rule(field, v)
+}
+
+// FieldNames rewrites the fields of the validation errors in err from Go field names to proto field names
+// (e.g. "DisplayName" to "display_name"), using the protobuf struct tags of msg's generated type.
+// Nested paths such as "Address.City" and "Items[2].Name" are mapped segment by segment;
+// segments that cannot be resolved are kept as they are. Other errors are returned unchanged.
+func FieldNames(msg interface{}, err error) error {
+	return mapNames(msg, err, false)
+}
+
+// JSONNames is like FieldNames but uses the proto JSON names (e.g. "displayName").
+func JSONNames(msg interface{}, err error) error {
+	return mapNames(msg, err, true)
+}
+
+func mapNames(msg interface{}, err error, json bool) error {
+	var verrs rapidval.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return err
+	}
+	t := reflect.TypeOf(msg)
+	for _, ve := range verrs {
+		ve.Field = mapPath(t, ve.Field, json)
+		if ve.MessageParams != nil {
+			ve.MessageParams[rapidval.Field] = ve.Field
+		}
+	}
+	return err
+}
+
+func mapPath(t reflect.Type, field string, json bool) string {
+	path := rapidval.ParsePath(field)
+	for i, seg := range path {
+		if seg.Name == "" {
+			if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Map) {
+				t = t.Elem()
+			}
+			continue
+		}
+
+		t = structType(t)
+		if t == nil {
+			break
+		}
+		sf, ok := t.FieldByName(seg.Name)
+		if !ok {
+			t = nil
+			continue
+		}
+		if protoName, jsonName := parseTag(sf.Tag.Get("protobuf")); protoName != "" {
+			if json {
+				path[i].Name = jsonName
+			} else {
+				path[i].Name = protoName
+			}
+		}
+		t = sf.Type
+	}
+	return path.String()
+}
+
+func structType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	return t
+}
+
+// parseTag extracts the proto and JSON names from a protobuf struct tag such as
+// "bytes,1,opt,name=display_name,json=displayName,proto3".
+func parseTag(tag string) (name, json string) {
+	for _, part := range strings.Split(tag, ",") {
+		switch {
+		case strings.HasPrefix(part, "name="):
+			name = part[len("name="):]
+		case strings.HasPrefix(part, "json="):
+			json = part[len("json="):]
+		}
+	}
+	if json == "" {
+		json = name
+	}
+	return name, json
+}
@@ -0,0 +1,118 @@
+package rapidvalproto
+
+import (
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// stringValue mimics wrapperspb.StringValue.
+type stringValue struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3"`
+}
+
+func (x *stringValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// The types below mimic protoc-gen-go output.
+type address struct {
+	PostalCode string `protobuf:"bytes,1,opt,name=postal_code,json=postalCode,proto3"`
+}
+
+type lineItem struct {
+	UnitPrice int64 `protobuf:"varint,1,opt,name=unit_price,json=unitPrice,proto3"`
+}
+
+type createUserRequest struct {
+	Nickname    *string      `protobuf:"bytes,1,opt,name=nickname,proto3,oneof"`
+	DisplayName *stringValue `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3"`
+	HomeAddress *address     `protobuf:"bytes,3,opt,name=home_address,json=homeAddress,proto3"`
+	LineItems   []*lineItem  `protobuf:"bytes,4,rep,name=line_items,json=lineItems,proto3"`
+}
+
+func (x *createUserRequest) Validations() rapidval.P {
+	p := rapidval.P{
+		Present("Nickname", x.Nickname != nil),
+		RequiredWrapper("DisplayName", x.DisplayName),
+		Wrapped("DisplayName", x.DisplayName, func(f string, v string) *rapidval.ValidationError {
+			return rapidval.MaxLength(f, v, 5)
+		}),
+	}
+	if x.HomeAddress != nil {
+		p = append(p, rapidval.Required("HomeAddress.PostalCode", x.HomeAddress.PostalCode))
+	}
+	for _, item := range x.LineItems {
+		p = append(p, rapidval.Between("LineItems[0].UnitPrice", int(item.UnitPrice), 1, 100))
+	}
+	return p
+}
+
+func TestPresence(t *testing.T) {
+	empty := ""
+	req := &createUserRequest{Nickname: &empty, DisplayName: &stringValue{}}
+	if err := rapidval.New().Validate(req); err != nil {
+		t.Errorf("present zero values should be valid, got %v", err)
+	}
+
+	req = &createUserRequest{}
+	verr, _ := rapidval.New().Validate(req).(rapidval.ValidationErrors)
+	if len(verr) != 2 {
+		t.Fatalf("got %d errors, want 2: %v", len(verr), verr)
+	}
+	for _, e := range verr {
+		if e.MessageKey != rapidval.MsgRequired {
+			t.Errorf("field %s: got %v, want %v", e.Field, e.MessageKey, rapidval.MsgRequired)
+		}
+	}
+
+	req = &createUserRequest{Nickname: &empty, DisplayName: &stringValue{Value: "too long"}}
+	verr, _ = rapidval.New().Validate(req).(rapidval.ValidationErrors)
+	if len(verr) != 1 || verr[0].MessageKey != rapidval.MsgMaxLength {
+		t.Errorf("unexpected errors: %v", verr)
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	var unset *stringValue
+	if _, ok := Unwrap(unset); ok {
+		t.Error("Unwrap() of nil wrapper should report unset")
+	}
+	if v, ok := Unwrap(&stringValue{Value: "x"}); !ok || v != "x" {
+		t.Errorf("Unwrap() = %v, %v, want x, true", v, ok)
+	}
+}
+
+func TestFieldNames(t *testing.T) {
+	req := &createUserRequest{
+		HomeAddress: &address{},
+		LineItems:   []*lineItem{{UnitPrice: 0}},
+	}
+
+	tests := []struct {
+		name  string
+		apply func(interface{}, error) error
+		want  []string
+	}{
+		{"proto names", FieldNames, []string{"nickname", "display_name", "home_address.postal_code", "line_items[0].unit_price"}},
+		{"json names", JSONNames, []string{"nickname", "displayName", "homeAddress.postalCode", "lineItems[0].unitPrice"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.apply(req, rapidval.New().Validate(req))
+			verr, _ := err.(rapidval.ValidationErrors)
+			if len(verr) != len(tt.want) {
+				t.Fatalf("got %d errors, want %d: %v", len(verr), len(tt.want), verr)
+			}
+			for i, e := range verr {
+				if e.Field != tt.want[i] || e.MessageParams[rapidval.Field] != tt.want[i] {
+					t.Errorf("error %d: field = %v, want %v", i, e.Field, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/9ssi7/rapidval/rapidvaltwirp
+
+go 1.23.0
+
+require (
+	github.com/9ssi7/rapidval v0.0.0
+	github.com/twitchtv/twirp v8.1.3+incompatible
+)
+
+require github.com/pkg/errors v0.9.1 // indirect
+
+replace github.com/9ssi7/rapidval => ../
@@ -0,0 +1,71 @@
+// Package rapidvaltwirp integrates rapidval with Twirp servers.
+//
+// Twirp's ServerHooks never see the decoded request message, so validation is
+// installed as a server interceptor instead:
+//
+//	server := pb.NewUserServiceServer(svc,
+//	    twirp.WithServerInterceptors(rapidvaltwirp.NewInterceptor(rapidval.NewTranslator())),
+//	)
+//
+// Requests implementing rapidval.Validateable are validated before the handler
+// runs; failures are returned as twirp.InvalidArgumentError with one metadata
+// entry per invalid field. Use NewInterceptorWithValidator to validate with a
+// configured Validator, e.g. one created with rapidval.WithTimeout.
+package rapidvaltwirp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/9ssi7/rapidval"
+	"github.com/twitchtv/twirp"
+)
+
+// NewInterceptor returns a Twirp interceptor that validates request messages implementing rapidval.Validateable.
+// The translator is used to render metadata values; if it is nil, message keys are used instead.
+func NewInterceptor(tr *rapidval.Translator) twirp.Interceptor {
+	return NewInterceptorWithValidator(rapidval.New(), tr)
+}
+
+// NewInterceptorWithValidator is like NewInterceptor but validates requests with v,
+// under the context of the request.
+func NewInterceptorWithValidator(v *rapidval.Validator, tr *rapidval.Translator) twirp.Interceptor {
+	return func(next twirp.Method) twirp.Method {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			val, ok := req.(rapidval.Validateable)
+			if !ok {
+				return next(ctx, req)
+			}
+			if err := v.ValidateContext(ctx, val); err != nil {
+				return nil, ToTwirpError(err, tr)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// ToTwirpError converts a validation error into a twirp.InvalidArgumentError.
+// The first invalid field becomes the error argument and every invalid field is added as metadata.
+// Errors that are not rapidval validation errors are wrapped as twirp.Internal errors.
+func ToTwirpError(err error, tr *rapidval.Translator) twirp.Error {
+	var verrs rapidval.ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) == 0 {
+		return twirp.InternalErrorWith(err)
+	}
+
+	terr := twirp.InvalidArgumentError(verrs[0].Field, message(verrs[0], tr))
+	for _, ve := range verrs {
+		if terr.Meta(ve.Field) != "" {
+			continue
+		}
+		terr = terr.WithMeta(ve.Field, message(ve, tr))
+	}
+	return terr
+}
+
+func message(ve *rapidval.ValidationError, tr *rapidval.Translator) string {
+	if tr == nil {
+		return ve.MessageKey
+	}
+	return tr.Translate(ve)
+}
@@ -0,0 +1,106 @@
+package rapidvaltwirp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+	"github.com/twitchtv/twirp"
+)
+
+type createUserRequest struct {
+	Name  string
+	Email string
+}
+
+func (r *createUserRequest) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.Required("Name", r.Name),
+		rapidval.Email("Email", r.Email),
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	called := false
+	method := NewInterceptor(nil)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return req, nil
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		called = false
+		_, err := method(context.Background(), &createUserRequest{Email: "invalid"})
+		if called {
+			t.Error("handler should not be called for an invalid request")
+		}
+
+		var terr twirp.Error
+		if !errors.As(err, &terr) {
+			t.Fatalf("expected twirp.Error, got %T", err)
+		}
+		if terr.Code() != twirp.InvalidArgument {
+			t.Errorf("Code() = %v, want %v", terr.Code(), twirp.InvalidArgument)
+		}
+		if got := terr.Meta("Name"); got != rapidval.MsgRequired {
+			t.Errorf("Meta(Name) = %v, want %v", got, rapidval.MsgRequired)
+		}
+		if got := terr.Meta("Email"); got != rapidval.MsgInvalidEmail {
+			t.Errorf("Meta(Email) = %v, want %v", got, rapidval.MsgInvalidEmail)
+		}
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		called = false
+		if _, err := method(context.Background(), &createUserRequest{Name: "John", Email: "john@example.com"}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if !called {
+			t.Error("handler should be called for a valid request")
+		}
+	})
+
+	t.Run("non validateable request", func(t *testing.T) {
+		called = false
+		if _, err := method(context.Background(), "plain"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if !called {
+			t.Error("handler should be called for a non validateable request")
+		}
+	})
+}
+
+type soldOutKey struct{}
+
+type orderRequest struct {
+	SKU string
+}
+
+func (r *orderRequest) Validations() rapidval.P {
+	return rapidval.P{
+		rapidval.RuleFunc(func(ctx context.Context) *rapidval.ValidationError {
+			if soldOut, _ := ctx.Value(soldOutKey{}).(string); soldOut == r.SKU {
+				return &rapidval.ValidationError{Field: "SKU", MessageKey: rapidval.MsgUnavailable}
+			}
+			return nil
+		}),
+	}
+}
+
+func TestInterceptorWithValidator(t *testing.T) {
+	v := rapidval.New(rapidval.WithKeyPrefix("orders."))
+	method := NewInterceptorWithValidator(v, nil)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	})
+
+	ctx := context.WithValue(context.Background(), soldOutKey{}, "A1")
+	_, err := method(ctx, &orderRequest{SKU: "A1"})
+	var terr twirp.Error
+	if !errors.As(err, &terr) {
+		t.Fatalf("expected twirp.Error, got %T", err)
+	}
+	if got, want := terr.Meta("SKU"), "orders."+rapidval.MsgUnavailable; got != want {
+		t.Errorf("Meta(SKU) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build js && wasm
+
+package rapidvalwasm
+
+import (
+	"syscall/js"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// Export installs a global JavaScript function with the given name that calls Validate.
+// The function takes a schema id and a JSON string and returns {valid, errors}.
+func Export(name string, tr *rapidval.Translator) {
+	js.Global().Set(name, js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if len(args) < 2 {
+			return js.ValueOf(Validate("", nil, tr))
+		}
+		return js.ValueOf(Validate(args[0].String(), []byte(args[1].String()), tr))
+	}))
+}
@@ -0,0 +1,61 @@
+// Package rapidvalwasm exposes registered schemas to JavaScript when compiled with GOOS=js GOARCH=wasm,
+// so the same rules run in browsers and on the server.
+//
+//	func main() {
+//	    schema.Register("signup", signupSchema)
+//	    rapidvalwasm.Export("rapidvalValidate", rapidval.NewTranslator())
+//	    select {}
+//	}
+//
+// From JavaScript:
+//
+//	const result = rapidvalValidate("signup", JSON.stringify(form));
+//	if (!result.valid) console.log(result.errors);
+//
+// Build the core without the reflection-based struct tag mode to keep the binary small:
+//
+//	GOOS=js GOARCH=wasm go build -tags rapidval_noreflect
+package rapidvalwasm
+
+import (
+	"github.com/9ssi7/rapidval"
+	"github.com/9ssi7/rapidval/schema"
+)
+
+// MsgUnknownSchema is the message key returned when no schema is registered under the requested id.
+const MsgUnknownSchema = "validation.unknown_schema"
+
+// Validate validates a JSON document against the schema registered under schemaID and returns
+// a result made of plain maps and slices, ready to be converted to a JavaScript value.
+//
+// The result has the shape {"valid": bool, "errors": [{"field", "key", "message"}]}, where fields
+// are JSON Pointers. Messages are translated when tr is not nil.
+func Validate(schemaID string, raw []byte, tr *rapidval.Translator) map[string]interface{} {
+	s, ok := schema.Lookup(schemaID)
+	if !ok {
+		return result(rapidval.ValidationErrors{{
+			MessageKey:    MsgUnknownSchema,
+			MessageParams: map[string]interface{}{"Schema": schemaID},
+		}}, tr)
+	}
+	return result(schema.ValidateJSON(raw, s), tr)
+}
+
+func result(errs rapidval.ValidationErrors, tr *rapidval.Translator) map[string]interface{} {
+	list := make([]interface{}, 0, len(errs))
+	for _, err := range errs {
+		msg := err.MessageKey
+		if tr != nil {
+			msg = tr.Translate(err)
+		}
+		list = append(list, map[string]interface{}{
+			"field":   err.Field,
+			"key":     err.MessageKey,
+			"message": msg,
+		})
+	}
+	return map[string]interface{}{
+		"valid":  len(errs) == 0,
+		"errors": list,
+	}
+}
@@ -0,0 +1,35 @@
+package rapidvalwasm
+
+import (
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+	"github.com/9ssi7/rapidval/schema"
+)
+
+func TestValidate(t *testing.T) {
+	schema.Register("wasm-signup", schema.Object(map[string]schema.Node{
+		"email": schema.String().Required().Email(),
+	}))
+
+	res := Validate("wasm-signup", []byte(`{"email":"john@example.com"}`), nil)
+	if res["valid"] != true {
+		t.Errorf("valid = %v, want true", res["valid"])
+	}
+
+	res = Validate("wasm-signup", []byte(`{"email":""}`), rapidval.NewTranslator())
+	errs := res["errors"].([]interface{})
+	if res["valid"] != false || len(errs) != 1 {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	first := errs[0].(map[string]interface{})
+	if first["field"] != "/email" || first["key"] != rapidval.MsgRequired || first["message"] != "/email alanı zorunludur" {
+		t.Errorf("unexpected error: %v", first)
+	}
+
+	res = Validate("unknown", []byte(`{}`), nil)
+	errs = res["errors"].([]interface{})
+	if len(errs) != 1 || errs[0].(map[string]interface{})["key"] != MsgUnknownSchema {
+		t.Errorf("unexpected result for unknown schema: %v", res)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/9ssi7/rapidval/rapidvalxtext
+
+go 1.23.0
+
+require (
+	github.com/9ssi7/rapidval v0.0.0
+	golang.org/x/text v0.21.0
+)
+
+replace github.com/9ssi7/rapidval => ../
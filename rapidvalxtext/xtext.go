@@ -0,0 +1,38 @@
+// Package rapidvalxtext sources rapidval messages from golang.org/x/text catalogs, so validation
+// messages live in the same catalogs as the rest of an application's x/text-based i18n.
+//
+// Catalog entries are keyed by rapidval message keys and hold Translator templates:
+//
+//	b := catalog.NewBuilder()
+//	b.SetString(language.German, rapidval.MsgRequired, "{{.Field}} ist erforderlich")
+//
+//	p := message.NewPrinter(language.German, message.Catalog(b))
+//	tr := rapidvalxtext.NewTranslator(p, "validation.unique")
+//
+// Messages are resolved through the printer, so a literal percent sign must be written as "%%".
+package rapidvalxtext
+
+import (
+	"github.com/9ssi7/rapidval"
+	"golang.org/x/text/message"
+)
+
+// Messages returns the templates p resolves for the built-in message keys and extraKeys,
+// which should list the keys of custom rules. Keys without a catalog entry are omitted.
+func Messages(p *message.Printer, extraKeys ...string) map[string]string {
+	keys := append(rapidval.MessageKeys(), extraKeys...)
+	messages := make(map[string]string, len(keys))
+	for _, key := range keys {
+		// The printer falls back to the key itself when the catalog has no entry for it.
+		if msg := p.Sprintf(key); msg != key {
+			messages[key] = msg
+		}
+	}
+	return messages
+}
+
+// NewTranslator creates a Translator from the messages p resolves, see Messages.
+// Keys without a catalog entry fall back to rapidval's default messages.
+func NewTranslator(p *message.Printer, extraKeys ...string) *rapidval.Translator {
+	return rapidval.NewTranslator().Overlay(Messages(p, extraKeys...))
+}
@@ -0,0 +1,45 @@
+package rapidvalxtext
+
+import (
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestNewTranslator(t *testing.T) {
+	b := catalog.NewBuilder()
+	if err := b.SetString(language.German, rapidval.MsgRequired, "{{.Field}} ist erforderlich"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.SetString(language.German, "validation.unique", "{{.Field}} ist bereits vergeben"); err != nil {
+		t.Fatal(err)
+	}
+	p := message.NewPrinter(language.German, message.Catalog(b))
+
+	messages := Messages(p, "validation.unique")
+	if len(messages) != 2 {
+		t.Errorf("Messages() = %v, want 2 entries", messages)
+	}
+
+	tr := NewTranslator(p, "validation.unique")
+	tests := []struct {
+		name string
+		err  *rapidval.ValidationError
+		want string
+	}{
+		{"catalog", rapidval.Required("Name", ""), "Name ist erforderlich"},
+		{"custom key", &rapidval.ValidationError{MessageKey: "validation.unique", MessageParams: map[string]interface{}{rapidval.Field: "Email"}}, "Email ist bereits vergeben"},
+		{"default fallback", rapidval.Email("Email", "x"), "Email geçerli bir email adresi olmalıdır"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tr.Translate(tt.err); got != tt.want {
+				t.Errorf("Translate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
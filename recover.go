@@ -0,0 +1,34 @@
+package rapidval
+
+// WithRepanic makes the Validator re-raise panics from Validations methods and lazy rules
+// instead of reporting them as MsgInternal errors. It is meant for development and tests,
+// where a stack trace is more useful than a failed validation.
+func WithRepanic() Option {
+	return func(v *Validator) {
+		v.repanic = true
+	}
+}
+
+// validations calls the validations function identified by src, converting a panic into a MsgInternal error.
+func (v *Validator) validations(src source, validations func() P) (params P, err *ValidationError) {
+	if !v.repanic {
+		defer func() {
+			if r := recover(); r != nil {
+				err = internalError(src.String(), r)
+			}
+		}()
+	}
+	return validations(), nil
+}
+
+// internalError reports a panic recovered from the named rule.
+// The panic value is kept in CurrentValue, and in Cause if it is an error; neither is meant to be shown
+// to end users, so it is left out of MessageParams, which translations and JSON responses expose.
+func internalError(name string, recovered interface{}) *ValidationError {
+	err := newError("", MsgInternal, recovered)
+	delete(err.MessageParams, Field)
+	delete(err.MessageParams, Value)
+	err.MessageParams[RuleName] = name
+	err.Cause, _ = recovered.(error)
+	return err
+}
@@ -0,0 +1,72 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+)
+
+type panicRuleStruct struct{}
+
+func (p *panicRuleStruct) Validations() P {
+	return P{
+		Required("Name", ""),
+		Named("lookup", RuleFunc(func(ctx context.Context) *ValidationError {
+			var m map[string]int
+			m["x"] = 1
+			return nil
+		})),
+	}
+}
+
+type panicValidationsStruct struct {
+	Owner *testStruct3
+}
+
+func (p *panicValidationsStruct) Validations() P {
+	return P{Required("Owner.Name", p.Owner.Name)}
+}
+
+func TestRecover(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      Validateable
+		wantKeys []string
+		wantRule string
+	}{
+		{"rule", &panicRuleStruct{}, []string{MsgRequired, MsgInternal}, "lookup"},
+		{"validations", &panicValidationsStruct{}, []string{MsgInternal}, "rapidval.panicValidationsStruct.Validations"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, ok := New().Validate(tt.val).(ValidationErrors)
+			if !ok || len(errs) != len(tt.wantKeys) {
+				t.Fatalf("Validate() = %v, want %v", errs, tt.wantKeys)
+			}
+			for i, key := range tt.wantKeys {
+				if errs[i].MessageKey != key {
+					t.Errorf("errs[%d].MessageKey = %v, want %v", i, errs[i].MessageKey, key)
+				}
+			}
+			last := errs[len(errs)-1]
+			if last.MessageParams[RuleName] != tt.wantRule {
+				t.Errorf("MessageParams[RuleName] = %v, want %v", last.MessageParams[RuleName], tt.wantRule)
+			}
+			if last.CurrentValue == nil {
+				t.Error("CurrentValue should hold the panic value")
+			}
+			if len(last.MessageParams) != 1 {
+				t.Errorf("MessageParams = %v, want only %s", last.MessageParams, RuleName)
+			}
+		})
+	}
+}
+
+func TestWithRepanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Validate() did not re-panic")
+		}
+	}()
+	_ = New(WithRepanic()).Validate(&panicRuleStruct{})
+}
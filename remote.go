@@ -0,0 +1,142 @@
+package rapidval
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrCircuitOpen is the Cause of the errors reported while a remote rule's Breaker rejects calls.
+var ErrCircuitOpen = errors.New("rapidval: circuit breaker is open")
+
+// RemoteFunc is a check that calls an external service, e.g. a VAT registry.
+// It returns a *ValidationError for invalid input, or a non-nil error if the check itself could not be completed.
+type RemoteFunc func(ctx context.Context) (*ValidationError, error)
+
+// FailureMode decides how a remote check that could not be completed is reported.
+type FailureMode int
+
+const (
+	// FailAsError reports a MsgUnavailable error, so the input is rejected.
+	FailAsError FailureMode = iota
+	// FailAsWarning reports a MsgUnavailable warning, see Warn.
+	FailAsWarning
+	// FailSkip lets the input pass as if the check had succeeded.
+	FailSkip
+)
+
+// Breaker is a circuit-breaker hook for remote rules. Allow is called before every attempt; while it
+// returns false the attempt is not made. Success and Failure report the outcome of each attempt.
+type Breaker interface {
+	Allow() bool
+	Success()
+	Failure()
+}
+
+// RemotePolicy configures how a remote rule retries and reports failed checks.
+type RemotePolicy struct {
+	// Attempts is the maximum number of attempts. Values below 1 mean a single attempt.
+	Attempts int
+	// Backoff returns the delay before retry n, starting at 1. A nil Backoff retries immediately.
+	Backoff func(n int) time.Duration
+	// Breaker, if set, is consulted before and informed after every attempt.
+	Breaker Breaker
+	// OnFailure decides how the failure is reported once all attempts have failed.
+	OnFailure FailureMode
+}
+
+// ExponentialBackoff returns a Backoff doubling base with every retry, capped at max.
+func ExponentialBackoff(base, max time.Duration) func(n int) time.Duration {
+	return func(n int) time.Duration {
+		d := base
+		for i := 1; i < n && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		return d
+	}
+}
+
+// Remote returns a lazily evaluated rule for field that runs check according to policy.
+// Failed attempts are retried with backoff until the context is done; the last error of a check that
+// could not be completed is kept as the Cause of the reported MsgUnavailable error.
+func Remote(field string, policy RemotePolicy, check RemoteFunc) Rule {
+	return remoteRule{field: field, policy: policy, check: check}
+}
+
+type remoteRule struct {
+	field  string
+	policy RemotePolicy
+	check  RemoteFunc
+}
+
+// Check implements Rule.
+func (r remoteRule) Check(ctx context.Context) *ValidationError {
+	err, _ := r.run(ctx)
+	return err
+}
+
+// run performs the check and reports whether it could be completed.
+func (r remoteRule) run(ctx context.Context) (*ValidationError, bool) {
+	attempts := r.policy.Attempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var lastErr error
+	for n := 0; n < attempts; n++ {
+		if n > 0 && r.policy.Backoff != nil {
+			if err := sleep(ctx, r.policy.Backoff(n)); err != nil {
+				lastErr = err
+				break
+			}
+		}
+		if r.policy.Breaker != nil && !r.policy.Breaker.Allow() {
+			lastErr = ErrCircuitOpen
+			break
+		}
+
+		verr, err := r.check(ctx)
+		if err == nil {
+			if r.policy.Breaker != nil {
+				r.policy.Breaker.Success()
+			}
+			return verr, true
+		}
+		lastErr = err
+		if r.policy.Breaker != nil {
+			r.policy.Breaker.Failure()
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return r.failure(lastErr), false
+}
+
+func (r remoteRule) failure(cause error) *ValidationError {
+	if r.policy.OnFailure == FailSkip {
+		return nil
+	}
+	err := newError(r.field, MsgUnavailable, nil)
+	err.Cause = cause
+	err.Warning = r.policy.OnFailure == FailAsWarning
+	return err
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
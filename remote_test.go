@@ -0,0 +1,119 @@
+package rapidval
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errVATDown = errors.New("vat registry unavailable")
+
+// flakyCheck fails with errVATDown until it has been called failures times.
+func flakyCheck(calls *int, failures int) RemoteFunc {
+	return func(ctx context.Context) (*ValidationError, error) {
+		*calls++
+		if *calls <= failures {
+			return nil, errVATDown
+		}
+		return nil, nil
+	}
+}
+
+type testBreaker struct {
+	open                bool
+	successes, failures int
+}
+
+func (b *testBreaker) Allow() bool { return !b.open }
+func (b *testBreaker) Success()    { b.successes++ }
+func (b *testBreaker) Failure()    { b.failures++ }
+
+func TestRemote(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      RemotePolicy
+		failures    int
+		wantCalls   int
+		wantKey     string
+		wantWarning bool
+	}{
+		{"success", RemotePolicy{}, 0, 1, "", false},
+		{"single attempt fails", RemotePolicy{}, 1, 1, MsgUnavailable, false},
+		{"retry succeeds", RemotePolicy{Attempts: 3}, 2, 3, "", false},
+		{"retries exhausted", RemotePolicy{Attempts: 2}, 5, 2, MsgUnavailable, false},
+		{"as warning", RemotePolicy{OnFailure: FailAsWarning}, 1, 1, MsgUnavailable, true},
+		{"skip", RemotePolicy{OnFailure: FailSkip}, 1, 1, "", false},
+		{"backoff", RemotePolicy{Attempts: 2, Backoff: ExponentialBackoff(time.Millisecond, time.Millisecond)}, 1, 2, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := Remote("VATID", tt.policy, flakyCheck(&calls, tt.failures)).Check(context.Background())
+			if calls != tt.wantCalls {
+				t.Errorf("check called %d times, want %d", calls, tt.wantCalls)
+			}
+			if tt.wantKey == "" {
+				if err != nil {
+					t.Errorf("Check() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.MessageKey != tt.wantKey || err.Field != "VATID" || err.Warning != tt.wantWarning {
+				t.Fatalf("Check() = %+v, want %v (warning %v)", err, tt.wantKey, tt.wantWarning)
+			}
+			if !errors.Is(err, errVATDown) {
+				t.Errorf("Check() cause = %v, want %v", err.Cause, errVATDown)
+			}
+		})
+	}
+}
+
+func TestRemoteInvalid(t *testing.T) {
+	check := func(ctx context.Context) (*ValidationError, error) {
+		return &ValidationError{Field: "VATID", MessageKey: "validation.vat"}, nil
+	}
+	if err := Remote("VATID", RemotePolicy{Attempts: 3}, check).Check(context.Background()); err == nil || err.MessageKey != "validation.vat" {
+		t.Errorf("Check() = %v, want the validation error of the check", err)
+	}
+}
+
+func TestRemoteBreaker(t *testing.T) {
+	b := &testBreaker{}
+	calls := 0
+	rule := Remote("VATID", RemotePolicy{Attempts: 2, Breaker: b}, flakyCheck(&calls, 1))
+	if err := rule.Check(context.Background()); err != nil {
+		t.Errorf("Check() = %v, want nil", err)
+	}
+	if b.failures != 1 || b.successes != 1 {
+		t.Errorf("breaker got %d failures and %d successes, want 1 and 1", b.failures, b.successes)
+	}
+
+	b.open = true
+	err := rule.Check(context.Background())
+	if err == nil || !errors.Is(err, ErrCircuitOpen) || calls != 2 {
+		t.Errorf("Check() with open breaker = %v after %d calls, want ErrCircuitOpen without calling", err, calls)
+	}
+}
+
+func TestRemoteContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls := 0
+	policy := RemotePolicy{Attempts: 5, Backoff: ExponentialBackoff(time.Hour, time.Hour)}
+	err := Remote("VATID", policy, flakyCheck(&calls, 5)).Check(ctx)
+	if err == nil || calls != 1 {
+		t.Errorf("Check() = %v after %d calls, want a failure after 1 call", err, calls)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond)
+	want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 50 * time.Millisecond, 50 * time.Millisecond}
+	for i, w := range want {
+		if got := backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package rapidval
+
+import "context"
+
+// Result is the outcome of Validator.Run. It separates errors from warnings and can be inspected
+// without type assertions.
+type Result struct {
+	errs     ValidationErrors
+	warnings ValidationErrors
+	score    *score
+}
+
+// Run validates val like Validate, but returns a Result instead of an error.
+func (v *Validator) Run(val Validateable) Result {
+	return v.RunContext(context.Background(), val)
+}
+
+// RunContext is like Run, but passes ctx to lazily evaluated rules and rule groups.
+func (v *Validator) RunContext(ctx context.Context, val Validateable) Result {
+	return v.scored(ctx, func(ctx context.Context) (errs, warnings ValidationErrors) {
+		return v.run(ctx, val)
+	})
+}
+
+// Valid reports whether validation produced no errors. Warnings do not make a result invalid.
+func (r Result) Valid() bool {
+	return len(r.errs) == 0
+}
+
+// Errors returns the validation errors, or nil if the result is valid.
+func (r Result) Errors() ValidationErrors {
+	return r.errs
+}
+
+// Warnings returns the errors produced by rules wrapped in Warn.
+func (r Result) Warnings() ValidationErrors {
+	return r.warnings
+}
+
+// FieldErrors returns the errors reported for field.
+func (r Result) FieldErrors(field string) ValidationErrors {
+	var errs ValidationErrors
+	for _, err := range r.errs {
+		if err.Field == field {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Err returns the errors as an error, as returned by Validate, or nil if the result is valid.
+func (r Result) Err() error {
+	if len(r.errs) == 0 {
+		return nil
+	}
+	return r.errs
+}
+
+// Warn groups rules whose failures are reported as warnings instead of errors, e.g. a weak but
+// acceptable password. Warnings are available through Result.Warnings and are not returned by Validate.
+func Warn(rules ...Rule) Rule {
+	return warnRule(rules)
+}
+
+type warnRule []Rule
+
+// Check implements Rule for callers evaluating the rule on its own. It returns the first warning only.
+func (w warnRule) Check(ctx context.Context) *ValidationError {
+	if errs := w.collect(ctx, &Validator{}, "", fieldPrefix{}, nil); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (w warnRule) collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	n := len(errs)
+	errs = v.collectRules(ctx, name, w, prefix, errs)
+	for _, err := range errs[n:] {
+		err.Warning = true
+	}
+	return errs
+}
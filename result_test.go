@@ -0,0 +1,64 @@
+package rapidval
+
+import (
+	"errors"
+	"testing"
+)
+
+type signupForm struct {
+	Email    string
+	Password string
+}
+
+func (s *signupForm) Validations() P {
+	return P{
+		Email("Email", s.Email),
+		Required("Password", s.Password),
+		Warn(
+			MinLength("Password", s.Password, 12),
+		),
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("errors and warnings", func(t *testing.T) {
+		res := New().Run(&signupForm{Email: "invalid", Password: "short"})
+		if res.Valid() {
+			t.Error("Valid() = true, want false")
+		}
+		if errs := res.Errors(); len(errs) != 1 || errs[0].MessageKey != MsgInvalidEmail {
+			t.Errorf("Errors() = %v, want the email error", errs)
+		}
+		if warnings := res.Warnings(); len(warnings) != 1 || warnings[0].MessageKey != MsgMinLength || !warnings[0].Warning {
+			t.Errorf("Warnings() = %v, want the password length warning", warnings)
+		}
+		if errs := res.FieldErrors("Email"); len(errs) != 1 {
+			t.Errorf("FieldErrors(Email) = %v, want 1 error", errs)
+		}
+		if errs := res.FieldErrors("Password"); len(errs) != 0 {
+			t.Errorf("FieldErrors(Password) = %v, want none", errs)
+		}
+		var verr ValidationErrors
+		if !errors.As(res.Err(), &verr) || len(verr) != 1 {
+			t.Errorf("Err() = %v, want ValidationErrors", res.Err())
+		}
+	})
+
+	t.Run("warnings only", func(t *testing.T) {
+		form := &signupForm{Email: "john@example.com", Password: "short"}
+		res := New().Run(form)
+		if !res.Valid() || res.Err() != nil || len(res.Warnings()) != 1 {
+			t.Errorf("Run() = %+v, want valid with 1 warning", res)
+		}
+		if err := New().Validate(form); err != nil {
+			t.Errorf("Validate() = %v, want warnings to be dropped", err)
+		}
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		res := New().Run(&signupForm{Email: "john@example.com", Password: "long enough password"})
+		if !res.Valid() || res.Errors() != nil || res.Warnings() != nil {
+			t.Errorf("Run() = %+v, want an empty result", res)
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package rapidval
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Rule is a validation rule evaluated by the Validator.
+//
+// The built-in rules such as Required run eagerly while P is built and return a *ValidationError,
+// which implements Rule by returning itself. Rules that are expensive or call other services can
+// instead be written as a RuleFunc, which the Validator evaluates lazily and can instrument.
+type Rule interface {
+	Check(ctx context.Context) *ValidationError
+}
+
+// Check implements Rule. It returns the error itself, which is nil for passing eager rules.
+func (ve *ValidationError) Check(ctx context.Context) *ValidationError {
+	return ve
+}
+
+// multiRule is implemented by rules that produce any number of errors, such as Dispatch and SkipUnless.
+// The Validator collects their errors directly instead of calling Check.
+type multiRule interface {
+	Rule
+	// collect appends the errors of the rule to errs. name identifies the enclosing Validations in traces.
+	collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors
+}
+
+// Check implements Rule, so that groups of rules such as Pagination can be nested in another P.
+// Called on its own it returns the first error only.
+func (p P) Check(ctx context.Context) *ValidationError {
+	if errs := p.collect(ctx, &Validator{}, "", fieldPrefix{}, nil); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (p P) collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	return v.collectRules(ctx, name, p, prefix, errs)
+}
+
+// When groups rules that only apply if condition holds, instead of building P with if statements:
+//
+//	rapidval.When(c.AccountType == "business",
+//	    rapidval.Required("CompanyName", c.CompanyName),
+//	    rapidval.Required("TaxID", c.TaxID),
+//	)
+//
+// Eager rules in the group are still evaluated while P is built, but their errors are discarded
+// if condition is false; lazy rules such as RuleFunc are not called.
+func When(condition bool, rules ...Rule) Rule {
+	if !condition {
+		return P(nil)
+	}
+	return P(rules)
+}
+
+// Unless groups rules that only apply if condition does not hold. It is the inverse of When.
+func Unless(condition bool, rules ...Rule) Rule {
+	return When(!condition, rules...)
+}
+
+// Chain groups rules that depend on each other: they are evaluated in order, and the rules after
+// the first one that fails are skipped, e.g. no MX lookup for an address that is not an email:
+//
+//	rapidval.Chain(
+//	    rapidval.Required("Email", u.Email),
+//	    rapidval.Email("Email", u.Email),
+//	    rapidval.RuleFunc(checkMX(u.Email)),
+//	)
+//
+// Rules outside the chain, including other chains, still all run. As with When, eager rules have
+// already been evaluated while P was built, and only their errors are discarded. Warnings do not
+// stop a chain.
+func Chain(rules ...Rule) Rule {
+	return chainRule(rules)
+}
+
+type chainRule []Rule
+
+// Check implements Rule for callers evaluating the rule on its own.
+func (c chainRule) Check(ctx context.Context) *ValidationError {
+	if errs := c.collect(ctx, &Validator{}, "", fieldPrefix{}, nil); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (c chainRule) collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	for i := range c {
+		n := len(errs)
+		errs = v.collectRules(ctx, name, c[i:i+1], prefix, errs)
+		if hasError(errs[n:]) {
+			break
+		}
+	}
+	return errs
+}
+
+// hasError reports whether errs contains an error that is not a warning.
+func hasError(errs ValidationErrors) bool {
+	for _, err := range errs {
+		if !err.Warning {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleFunc adapts a function to the Rule interface. It is evaluated lazily during Validate.
+type RuleFunc func(ctx context.Context) *ValidationError
+
+// Check implements Rule.
+func (f RuleFunc) Check(ctx context.Context) *ValidationError {
+	return f(ctx)
+}
+
+// Custom is a lazily evaluated rule for a check that is not built in, e.g. one that needs a database.
+// The Validator calls ok with its context, and if ok returns false, reports key with the Field and
+// Value params like the built-in rules do:
+//
+//	rapidval.Custom("Email", u.Email, "validation.unique", users.EmailAvailable)
+//
+// Unless renamed with Named, the rule is reported by Validator.Stats under the name of ok.
+func Custom[T any](field string, value T, key string, ok func(ctx context.Context, value T) bool) Rule {
+	return customRule[T]{field: field, value: value, key: key, ok: ok}
+}
+
+type customRule[T any] struct {
+	field string
+	value T
+	key   string
+	ok    func(ctx context.Context, value T) bool
+}
+
+// Check implements Rule.
+func (c customRule[T]) Check(ctx context.Context) *ValidationError {
+	if c.ok(ctx, c.value) {
+		return nil
+	}
+	return newError(c.field, c.key, c.value)
+}
+
+func (c customRule[T]) funcName() string {
+	return funcName(c.ok)
+}
+
+// Named gives a rule the name under which it is reported by Validator.Stats.
+// Unnamed rules are reported under the name of their function.
+func Named(name string, rule Rule) Rule {
+	return namedRule{name: name, Rule: rule}
+}
+
+type namedRule struct {
+	Rule
+	name string
+}
+
+// ruleName returns the name a lazily evaluated rule is reported under.
+func ruleName(rule Rule) string {
+	switch r := rule.(type) {
+	case namedRule:
+		return r.name
+	case RuleFunc:
+		return funcName(r)
+	case cachedRule:
+		return ruleName(r.rule)
+	case remoteRule:
+		return funcName(r.check)
+	case interface{ funcName() string }:
+		return r.funcName()
+	}
+	return fmt.Sprintf("%T", rule)
+}
+
+// validationsName returns the name the Validations method of val is reported under, e.g. "examples.User.Validations".
+func validationsName(val Validateable) string {
+	return source{value: val, suffix: ".Validations"}.String()
+}
+
+// source identifies validations in stats, traces, metrics and internal errors: either by name, or by
+// the type of value followed by suffix, e.g. "examples.User.Validations". Formatting the type name
+// allocates, so it is only done when the name is needed.
+type source struct {
+	name   string
+	value  interface{}
+	suffix string
+}
+
+// String returns the name of the validations.
+func (s source) String() string {
+	if s.name != "" || s.value == nil {
+		return s.name
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", s.value), "*") + s.suffix
+}
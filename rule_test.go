@@ -0,0 +1,271 @@
+package rapidval
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type lazyStruct struct {
+	Username string
+	checked  *int
+}
+
+func (l *lazyStruct) Validations() P {
+	return P{
+		Required("Username", l.Username),
+		RuleFunc(func(ctx context.Context) *ValidationError {
+			*l.checked++
+			if l.Username == "taken" {
+				return &ValidationError{Field: "Username", MessageKey: "validation.unique"}
+			}
+			return nil
+		}),
+		nil,
+	}
+}
+
+func TestRuleFunc(t *testing.T) {
+	checked := 0
+
+	if err := New().Validate(&lazyStruct{Username: "free", checked: &checked}); err != nil {
+		t.Errorf("Validate() returned error: %v", err)
+	}
+
+	verr, ok := New().Validate(&lazyStruct{Username: "taken", checked: &checked}).(ValidationErrors)
+	if !ok || len(verr) != 1 || verr[0].MessageKey != "validation.unique" {
+		t.Errorf("Validate() = %v, want validation.unique", verr)
+	}
+
+	if checked != 2 {
+		t.Errorf("rule evaluated %d times, want 2", checked)
+	}
+}
+
+func emailAvailable(ctx context.Context, email string) bool {
+	taken, _ := ctx.Value(ctxKey{}).(string)
+	return email != taken
+}
+
+func TestCustom(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "taken@example.com")
+	if err := Custom("Email", "free@example.com", "validation.unique", emailAvailable).Check(ctx); err != nil {
+		t.Errorf("Check() = %v, want nil", err)
+	}
+	err := Custom("Email", "taken@example.com", "validation.unique", emailAvailable).Check(ctx)
+	if err == nil || err.Field != "Email" || err.MessageKey != "validation.unique" ||
+		err.MessageParams[Field] != "Email" || err.MessageParams[Value] != "taken@example.com" {
+		t.Errorf("Check() = %+v, want validation.unique for Email", err)
+	}
+
+	calls := 0
+	rule := Custom("Age", 10, "validation.adult", func(ctx context.Context, age int) bool {
+		calls++
+		return age >= 18
+	})
+	v := New(WithStats())
+	if verr, ok := v.Validate(lazyRules{rule}).(ValidationErrors); !ok || len(verr) != 1 || calls != 1 {
+		t.Errorf("Validate() = %v after %d calls, want one error after one call", verr, calls)
+	}
+	if s := v.Stats()[ruleName(rule)]; !strings.Contains(ruleName(rule), "TestCustom") || s.Calls != 1 || s.Failures != 1 {
+		t.Errorf("Stats()[%q] = %+v, want 1 call and 1 failure", ruleName(rule), s)
+	}
+}
+
+type lazyRules P
+
+func (l lazyRules) Validations() P {
+	return P(l)
+}
+
+func TestRuleName(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+		want string
+	}{
+		{"named", Named("unique_username", RuleFunc(func(ctx context.Context) *ValidationError { return nil })), "unique_username"},
+		{"func", RuleFunc(func(ctx context.Context) *ValidationError { return nil }), "github.com/9ssi7/rapidval.TestRuleName.func"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruleName(tt.rule); !strings.HasPrefix(got, tt.want) {
+				t.Errorf("ruleName() = %v, want prefix %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type accountForm struct {
+	AccountType string
+	CompanyName string
+	FullName    string
+	lookups     *int
+}
+
+func (a *accountForm) Validations() P {
+	return P{
+		When(a.AccountType == "business",
+			Required("CompanyName", a.CompanyName),
+			RuleFunc(func(ctx context.Context) *ValidationError {
+				*a.lookups++
+				return nil
+			}),
+		),
+		Unless(a.AccountType == "business",
+			Required("FullName", a.FullName),
+		),
+	}
+}
+
+func TestWhenUnless(t *testing.T) {
+	tests := []struct {
+		name        string
+		form        accountForm
+		want        []string
+		wantLookups int
+	}{
+		{"business", accountForm{AccountType: "business"}, []string{"CompanyName"}, 1},
+		{"business valid", accountForm{AccountType: "business", CompanyName: "Acme"}, nil, 1},
+		{"personal", accountForm{AccountType: "personal"}, []string{"FullName"}, 0},
+		{"personal valid", accountForm{AccountType: "personal", FullName: "Ada"}, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookups := 0
+			tt.form.lookups = &lookups
+			verr, _ := New().Validate(&tt.form).(ValidationErrors)
+			if len(verr) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want errors on %v", verr, tt.want)
+			}
+			for i, field := range tt.want {
+				if verr[i].Field != field {
+					t.Errorf("errs[%d].Field = %v, want %v", i, verr[i].Field, field)
+				}
+			}
+			if lookups != tt.wantLookups {
+				t.Errorf("lazy rule called %d times, want %d", lookups, tt.wantLookups)
+			}
+		})
+	}
+
+	if err := When(true, Required("A", ""), Required("B", "")).Check(context.Background()); err == nil || err.Field != "A" {
+		t.Errorf("When().Check() = %v, want error on A", err)
+	}
+	if err := When(false, Required("A", "")).Check(context.Background()); err != nil {
+		t.Errorf("When(false).Check() = %v, want nil", err)
+	}
+}
+
+type contactForm struct {
+	Email   string
+	Backup  string
+	lookups *int
+}
+
+func (f *contactForm) Validations() P {
+	mx := func(email string) RuleFunc {
+		return func(ctx context.Context) *ValidationError {
+			*f.lookups++
+			return nil
+		}
+	}
+	return P{
+		Chain(
+			Required("Email", f.Email),
+			Email("Email", f.Email),
+			mx(f.Email),
+		),
+		Chain(
+			Warn(MinLength("Backup", f.Backup, 20)),
+			Email("Backup", f.Backup),
+			mx(f.Backup),
+		),
+	}
+}
+
+func TestChain(t *testing.T) {
+	tests := []struct {
+		name        string
+		form        contactForm
+		want        []string
+		wantLookups int
+	}{
+		{"required fails", contactForm{Backup: "b@example.com"}, []string{MsgRequired}, 1},
+		{"email fails", contactForm{Email: "invalid", Backup: "b@example.com"}, []string{MsgInvalidEmail}, 1},
+		{"both chains fail", contactForm{Email: "invalid", Backup: "invalid"}, []string{MsgInvalidEmail, MsgInvalidEmail}, 0},
+		{"valid", contactForm{Email: "a@example.com", Backup: "b@example.com"}, nil, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookups := 0
+			tt.form.lookups = &lookups
+			res := New().Run(&tt.form)
+			var keys []string
+			for _, err := range res.Errors() {
+				keys = append(keys, err.MessageKey)
+			}
+			if !reflect.DeepEqual(keys, tt.want) {
+				t.Errorf("Errors() keys = %v, want %v", keys, tt.want)
+			}
+			if lookups != tt.wantLookups {
+				t.Errorf("lazy rule called %d times, want %d", lookups, tt.wantLookups)
+			}
+		})
+	}
+
+	if err := Chain(Required("A", ""), Required("B", "")).Check(context.Background()); err == nil || err.Field != "A" {
+		t.Errorf("Chain().Check() = %v, want error on A", err)
+	}
+}
+
+type ctxKey struct{}
+
+type ctxSignup struct {
+	Email string
+	seen  *context.Context
+}
+
+func (s *ctxSignup) Validations() P { return s.ValidationsContext(context.Background()) }
+
+func (s *ctxSignup) ValidationsContext(ctx context.Context) P {
+	*s.seen = ctx
+	return P{
+		When(ctx.Value(ctxKey{}) == s.Email, &ValidationError{Field: "Email", MessageKey: "validation.unique"}),
+	}
+}
+
+type ctxSignupPage struct {
+	Signup *ctxSignup
+}
+
+func (p *ctxSignupPage) Validations() P {
+	return P{Nested("Signup", p.Signup)}
+}
+
+func TestValidateableCtx(t *testing.T) {
+	var seen context.Context
+	ctx := context.WithValue(context.Background(), ctxKey{}, "taken@example.com")
+
+	err := New().ValidateContext(ctx, &ctxSignup{Email: "taken@example.com", seen: &seen})
+	if verrs, _ := err.(ValidationErrors); len(verrs) != 1 || verrs[0].MessageKey != "validation.unique" {
+		t.Errorf("ValidateContext() = %v, want validation.unique", err)
+	}
+	if seen != ctx {
+		t.Error("ValidationsContext was not called with the context passed to ValidateContext")
+	}
+
+	err = New().ValidateContext(ctx, &ctxSignupPage{Signup: &ctxSignup{Email: "taken@example.com", seen: &seen}})
+	if verrs, _ := err.(ValidationErrors); len(verrs) != 1 || verrs[0].Field != "Signup.Email" {
+		t.Errorf("ValidateContext() nested = %v, want error on Signup.Email", err)
+	}
+
+	if err := New().Validate(&ctxSignup{Email: "taken@example.com", seen: &seen}); err != nil {
+		t.Errorf("Validate() = %v, want nil without the context value", err)
+	}
+	if seen == ctx {
+		t.Error("Validate should not see the context of an earlier call")
+	}
+}
@@ -0,0 +1,160 @@
+package schema
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// Constraint describes a single rule of a schema node: the message key it fails with
+// and the parameters it adds to the error.
+type Constraint struct {
+	MessageKey string
+	Params     map[string]interface{}
+}
+
+// String returns a short human-readable form of the constraint, e.g. "min length 2".
+func (c Constraint) String() string {
+	switch c.MessageKey {
+	case rapidval.MsgMinLength:
+		return fmt.Sprintf("min length %v", c.Params[rapidval.Min])
+	case rapidval.MsgMaxLength:
+		return fmt.Sprintf("max length %v", c.Params[rapidval.Max])
+	case rapidval.MsgMinItems:
+		return fmt.Sprintf("min items %v", c.Params[rapidval.Min])
+	case rapidval.MsgMaxItems:
+		return fmt.Sprintf("max items %v", c.Params[rapidval.Max])
+	case rapidval.MsgMin:
+		return fmt.Sprintf("min %v", c.Params[rapidval.Min])
+	case rapidval.MsgMax:
+		return fmt.Sprintf("max %v", c.Params[rapidval.Max])
+	case rapidval.MsgInvalidEmail:
+		return "email"
+	case rapidval.MsgOneOf:
+		if allowed, ok := c.Params[rapidval.Allowed].([]string); ok {
+			return "one of " + strings.Join(allowed, ", ")
+		}
+	}
+	return c.MessageKey
+}
+
+// FieldDoc documents a single field of a schema.
+// Nested fields are named "address.city" and array elements "tags[]".
+type FieldDoc struct {
+	Field       string
+	Type        string
+	Required    bool
+	Constraints []Constraint
+}
+
+// MessageKeys returns the message keys the field can fail with, excluding MsgInvalidType.
+func (d FieldDoc) MessageKeys() []string {
+	var keys []string
+	if d.Required {
+		keys = append(keys, rapidval.MsgRequired)
+	}
+	for _, c := range d.Constraints {
+		keys = append(keys, c.MessageKey)
+	}
+	return keys
+}
+
+// Describe lists the fields of a schema with their types and constraints, in the order they are validated.
+// A root object contributes only its fields.
+func Describe(n Node) []FieldDoc {
+	return n.describe("", nil)
+}
+
+// WriteMarkdown writes a Markdown section with a table of fields, constraints and message keys
+// for each of the given registered schemas, or for all registered schemas if no id is given.
+func WriteMarkdown(w io.Writer, ids ...string) error {
+	schemas, err := lookupAll(ids)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	for i, s := range schemas {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "## %s\n\n", s.ID)
+		b.WriteString("| Field | Type | Required | Constraints | Message keys |\n")
+		b.WriteString("|-------|------|----------|-------------|--------------|\n")
+		for _, d := range s.Fields {
+			fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n",
+				d.Field, d.Type, yesNo(d.Required), markdownEscape(constraintList(d.Constraints)), codeList(d.MessageKeys()))
+		}
+	}
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// WriteHTML writes an HTML section with a table of fields, constraints and message keys
+// for each of the given registered schemas, or for all registered schemas if no id is given.
+func WriteHTML(w io.Writer, ids ...string) error {
+	schemas, err := lookupAll(ids)
+	if err != nil {
+		return err
+	}
+	return htmlDoc.Execute(w, schemas)
+}
+
+var htmlDoc = template.Must(template.New("doc").Funcs(template.FuncMap{
+	"yesNo":       yesNo,
+	"constraints": constraintList,
+}).Parse(`{{range .}}<h2>{{.ID}}</h2>
+<table>
+<tr><th>Field</th><th>Type</th><th>Required</th><th>Constraints</th><th>Message keys</th></tr>
+{{range .Fields}}<tr><td><code>{{.Field}}</code></td><td>{{.Type}}</td><td>{{yesNo .Required}}</td><td>{{constraints .Constraints}}</td><td>{{range $i, $k := .MessageKeys}}{{if $i}}, {{end}}<code>{{$k}}</code>{{end}}</td></tr>
+{{end}}</table>
+{{end}}`))
+
+type schemaDoc struct {
+	ID     string
+	Fields []FieldDoc
+}
+
+func lookupAll(ids []string) ([]schemaDoc, error) {
+	if len(ids) == 0 {
+		ids = IDs()
+	}
+	docs := make([]schemaDoc, 0, len(ids))
+	for _, id := range ids {
+		s, ok := Lookup(id)
+		if !ok {
+			return nil, fmt.Errorf("schema: %q is not registered", id)
+		}
+		docs = append(docs, schemaDoc{ID: id, Fields: Describe(s)})
+	}
+	return docs, nil
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func constraintList(cs []Constraint) string {
+	parts := make([]string, len(cs))
+	for i, c := range cs {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, "; ")
+}
+
+func codeList(keys []string) string {
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = "`" + k + "`"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
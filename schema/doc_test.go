@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	got := Describe(signupSchema())
+	want := []struct {
+		field, typ, constraints string
+		required                bool
+	}{
+		{"address", "object", "", true},
+		{"address.city", "string", "", true},
+		{"age", "integer", "min 18; max 100", true},
+		{"email", "string", "email", true},
+		{"name", "string", "min length 2; max length 20", true},
+		{"role", "string", "one of admin, user", false},
+		{"tags", "array", "max items 2", false},
+		{"tags[]", "string", "max length 5", false},
+		{"terms", "boolean", "", true},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Describe() returned %d fields, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		d := got[i]
+		if d.Field != w.field || d.Type != w.typ || d.Required != w.required || constraintList(d.Constraints) != w.constraints {
+			t.Errorf("Describe()[%d] = %s %s %v %q, want %s %s %v %q",
+				i, d.Field, d.Type, d.Required, constraintList(d.Constraints), w.field, w.typ, w.required, w.constraints)
+		}
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	Register("doc.signup", signupSchema())
+
+	var b strings.Builder
+	if err := WriteMarkdown(&b, "doc.signup"); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"## doc.signup\n",
+		"| `name` | string | yes | min length 2; max length 20 | `validation.required`, `validation.min_length`, `validation.max_length` |\n",
+		"| `role` | string | no | one of admin, user | `validation.one_of` |\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("WriteMarkdown() output missing %q:\n%s", want, out)
+		}
+	}
+
+	if err := WriteMarkdown(&b, "doc.missing"); err == nil {
+		t.Error("WriteMarkdown() with unknown id should fail")
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	Register("doc.signup", signupSchema())
+
+	var b strings.Builder
+	if err := WriteHTML(&b, "doc.signup"); err != nil {
+		t.Fatalf("WriteHTML() error = %v", err)
+	}
+	want := "<tr><td><code>age</code></td><td>integer</td><td>yes</td><td>min 18; max 100</td><td><code>validation.required</code>, <code>validation.min</code>, <code>validation.max</code></td></tr>"
+	if !strings.Contains(b.String(), want) {
+		t.Errorf("WriteHTML() output missing %q:\n%s", want, b.String())
+	}
+}
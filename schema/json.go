@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// ValidateJSON validates a raw JSON document against an object schema without decoding it into a struct.
+// Only the parts of the document described by the schema are decoded; nested objects and arrays are
+// walked lazily. Failures are reported with JSON Pointer fields, e.g. "/items/2/price".
+// A document that is not valid JSON yields a single MsgInvalidJSON error for the root pointer "".
+// Limits such as MaxDepth are checked on the whole document, including the parts the schema does not describe.
+func ValidateJSON(raw json.RawMessage, s *ObjectSchema, opts ...Option) rapidval.ValidationErrors {
+	p := path{pointer: true}
+	if !json.Valid(raw) {
+		return rapidval.ValidationErrors{newError(p, rapidval.MsgInvalidJSON, string(raw), nil)}
+	}
+	if l := newLimits(opts); l.enabled() {
+		w := limitWalker{limits: l}
+		if err := w.walkJSON(p, raw); err != nil {
+			return rapidval.ValidationErrors{err}
+		}
+	}
+	return validateRaw(s, p, raw, nil)
+}
+
+func validateRaw(n Node, p path, raw json.RawMessage, errs rapidval.ValidationErrors) rapidval.ValidationErrors {
+	if raw == nil || bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return n.validate(p, nil, errs)
+	}
+
+	switch n := n.(type) {
+	case *ObjectSchema:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return append(errs, typeError(p, "object", decodeRaw(raw)))
+		}
+		for _, k := range n.keys {
+			errs = validateRaw(n.fields[k], p.key(k), fields[k], errs)
+		}
+		return errs
+	case *ArraySchema:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return append(errs, typeError(p, "array", decodeRaw(raw)))
+		}
+		if (n.min >= 0 && len(items) < n.min) || (n.max >= 0 && len(items) > n.max) {
+			errs = n.checkLen(p, decodeRaw(raw), len(items), errs)
+		}
+		if n.elem == nil {
+			return errs
+		}
+		for i, item := range items {
+			errs = validateRaw(n.elem, p.index(i), item, errs)
+		}
+		return errs
+	}
+	return n.validate(p, decodeRaw(raw), errs)
+}
+
+func decodeRaw(raw json.RawMessage) interface{} {
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return string(raw)
+	}
+	return v
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+func orderSchema() *ObjectSchema {
+	return Object(map[string]Node{
+		"customer": Object(map[string]Node{
+			"email": String().Required().Email(),
+		}).Required(),
+		"items": Array(Object(map[string]Node{
+			"sku":        String().Required(),
+			"price":      Number().Required().Min(0),
+			"unit/price": Number().Min(0),
+		})).Required().Min(1),
+	})
+}
+
+func TestValidateJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want map[string]string
+	}{
+		{
+			name: "valid document",
+			raw:  `{"customer":{"email":"john@example.com"},"items":[{"sku":"A1","price":10}],"ignored":{"deep":[1,2,3]}}`,
+		},
+		{
+			name: "nested failures",
+			raw:  `{"customer":{"email":"invalid"},"items":[{"sku":"A1","price":10},{"sku":"","price":-1,"unit/price":-2},{"price":"free"}]}`,
+			want: map[string]string{
+				"/customer/email":      rapidval.MsgInvalidEmail,
+				"/items/1/sku":         rapidval.MsgRequired,
+				"/items/1/price":       rapidval.MsgMin,
+				"/items/1/unit~1price": rapidval.MsgMin,
+				"/items/2/sku":         rapidval.MsgRequired,
+				"/items/2/price":       rapidval.MsgInvalidType,
+			},
+		},
+		{
+			name: "missing and empty",
+			raw:  `{"customer":null,"items":[]}`,
+			want: map[string]string{
+				"/customer": rapidval.MsgRequired,
+				"/items":    rapidval.MsgMinItems,
+			},
+		},
+		{
+			name: "wrong container types",
+			raw:  `{"customer":"john","items":{}}`,
+			want: map[string]string{
+				"/customer": rapidval.MsgInvalidType,
+				"/items":    rapidval.MsgInvalidType,
+			},
+		},
+		{
+			name: "malformed document",
+			raw:  `{"customer":`,
+			want: map[string]string{"": rapidval.MsgInvalidJSON},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateJSON(json.RawMessage(tt.raw), orderSchema())
+			got := make(map[string]string, len(errs))
+			for _, e := range errs {
+				got[e.Field] = e.MessageKey
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("got errors %v, want %v", got, tt.want)
+			}
+			for field, key := range tt.want {
+				if got[field] != key {
+					t.Errorf("field %q: got %v, want %v", field, got[field], key)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkValidateJSON(b *testing.B) {
+	raw := json.RawMessage(`{"customer":{"email":"john@example.com"},"items":[{"sku":"A1","price":10},{"sku":"B2","price":5}]}`)
+	s := orderSchema()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ValidateJSON(raw, s)
+	}
+}
@@ -0,0 +1,169 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// Option configures Validate and ValidateJSON.
+type Option func(*limits)
+
+// limits caps the size of a document. Zero fields are unlimited.
+type limits struct {
+	depth       int
+	elements    int
+	stringBytes int
+}
+
+// MaxDepth limits how deeply objects and arrays may be nested; a flat object has depth 1.
+func MaxDepth(n int) Option {
+	return func(l *limits) { l.depth = n }
+}
+
+// MaxElements limits the total number of object members and array elements in the document.
+func MaxElements(n int) Option {
+	return func(l *limits) { l.elements = n }
+}
+
+// MaxStringBytes limits the total length in bytes of all strings in the document, object keys included.
+func MaxStringBytes(n int) Option {
+	return func(l *limits) { l.stringBytes = n }
+}
+
+func newLimits(opts []Option) limits {
+	var l limits
+	for _, opt := range opts {
+		opt(&l)
+	}
+	return l
+}
+
+func (l limits) enabled() bool {
+	return l.depth > 0 || l.elements > 0 || l.stringBytes > 0
+}
+
+// limitWalker checks a document against limits before it is validated, so pathological payloads are
+// rejected without running the schema on them. Only the first exceeded limit is reported.
+type limitWalker struct {
+	limits
+	elements    int
+	stringBytes int
+}
+
+// exceeded returns the error for the first limit exceeded at p, or nil.
+func (w *limitWalker) exceeded(p path, depth int, value interface{}) *rapidval.ValidationError {
+	var key string
+	var max int
+	switch {
+	case w.depth > 0 && depth > w.depth:
+		key, max = rapidval.MsgMaxDepth, w.depth
+	case w.limits.elements > 0 && w.elements > w.limits.elements:
+		key, max = rapidval.MsgMaxElements, w.limits.elements
+	case w.limits.stringBytes > 0 && w.stringBytes > w.limits.stringBytes:
+		key, max = rapidval.MsgMaxStringBytes, w.limits.stringBytes
+	default:
+		return nil
+	}
+	return newError(p, key, value, map[string]interface{}{rapidval.Max: max})
+}
+
+// walk checks decoded JSON values: map[string]interface{}, []interface{} and strings.
+// Values of other types, such as structs, count as a single element and are not descended into.
+func (w *limitWalker) walk(p path, depth int, value interface{}) *rapidval.ValidationError {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		depth++
+		if err := w.exceeded(p, depth, nil); err != nil {
+			return err
+		}
+		for k, item := range v {
+			w.elements++
+			w.stringBytes += len(k)
+			if err := w.walk(p.key(k), depth, item); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		depth++
+		if err := w.exceeded(p, depth, nil); err != nil {
+			return err
+		}
+		for i, item := range v {
+			w.elements++
+			if err := w.walk(p.index(i), depth, item); err != nil {
+				return err
+			}
+		}
+	case string:
+		w.stringBytes += len(v)
+	}
+	return w.exceeded(p, depth, nil)
+}
+
+// walkJSON checks a raw JSON document token by token, without decoding it.
+func (w *limitWalker) walkJSON(root path, raw json.RawMessage) *rapidval.ValidationError {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	// frame is an open object or array: its path, the index of its next element,
+	// and for objects the last key read and whether the next string is a key.
+	type frame struct {
+		p         path
+		object    bool
+		index     int
+		key       string
+		expectKey bool
+	}
+	var stack []*frame
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			// io.EOF ends the document; malformed documents have already been rejected by json.Valid.
+			return nil
+		}
+		var top *frame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+		if d, ok := tok.(json.Delim); ok && (d == '}' || d == ']') {
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 && stack[len(stack)-1].object {
+				stack[len(stack)-1].expectKey = true
+			}
+			continue
+		}
+		if top != nil && top.expectKey {
+			top.key = tok.(string)
+			top.expectKey = false
+			w.elements++
+			w.stringBytes += len(top.key)
+			if err := w.exceeded(top.p.key(top.key), len(stack), nil); err != nil {
+				return err
+			}
+			continue
+		}
+
+		p := root
+		switch {
+		case top == nil:
+		case top.object:
+			p = top.p.key(top.key)
+			top.expectKey = true
+		default:
+			p = top.p.index(top.index)
+			top.index++
+			w.elements++
+		}
+		switch v := tok.(type) {
+		case json.Delim:
+			stack = append(stack, &frame{p: p, object: v == '{', expectKey: v == '{'})
+		case string:
+			w.stringBytes += len(v)
+		}
+		if err := w.exceeded(p, len(stack), nil); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+func TestLimits(t *testing.T) {
+	s := Object(map[string]Node{"name": String()})
+
+	tests := []struct {
+		name      string
+		json      string
+		opts      []Option
+		wantKey   string
+		wantField string
+		wantPtr   string
+		// unordered is set when map iteration order decides which member of a decoded object
+		// crosses the limit, so Validate may report any of them.
+		unordered bool
+	}{
+		{"within limits", `{"name":"John","meta":{"a":[1,2]}}`, []Option{MaxDepth(3), MaxElements(5), MaxStringBytes(13)}, "", "", "", false},
+		{"no limits", `{"a":{"b":{"c":{"d":{}}}}}`, nil, "", "", "", false},
+		{"too deep", `{"name":"John","meta":{"a":[[1]]}}`, []Option{MaxDepth(3)}, rapidval.MsgMaxDepth, "meta.a[0]", "/meta/a/0", false},
+		{"array depth", `{"a":[]}`, []Option{MaxDepth(1)}, rapidval.MsgMaxDepth, "a", "/a", false},
+		{"too many elements", `{"tags":[1,2,3,4]}`, []Option{MaxElements(4)}, rapidval.MsgMaxElements, "tags[3]", "/tags/3", false},
+		{"too many string bytes", `{"name":"John","bio":"0123456789"}`, []Option{MaxStringBytes(16)}, rapidval.MsgMaxStringBytes, "bio", "/bio", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(tt.json), &decoded); err != nil {
+				t.Fatal(err)
+			}
+			check := func(mode string, errs rapidval.ValidationErrors, wantField string) {
+				t.Helper()
+				if tt.wantKey == "" {
+					if len(errs) > 0 {
+						t.Errorf("%s: unexpected errors %v", mode, errs)
+					}
+					return
+				}
+				if len(errs) != 1 || errs[0].MessageKey != tt.wantKey || errs[0].Field != wantField {
+					t.Errorf("%s: errors = %+v, want %s on %q", mode, errs, tt.wantKey, wantField)
+					return
+				}
+				if _, ok := errs[0].MessageParams[rapidval.Max]; !ok {
+					t.Errorf("%s: missing Max param", mode)
+				}
+			}
+
+			errs, _ := Validate(s, decoded, tt.opts...).(rapidval.ValidationErrors)
+			if !tt.unordered {
+				check("Validate", errs, tt.wantField)
+			} else if len(errs) != 1 || errs[0].MessageKey != tt.wantKey {
+				t.Errorf("Validate: errors = %v, want %s", errs, tt.wantKey)
+			}
+			check("ValidateJSON", ValidateJSON(json.RawMessage(tt.json), s, tt.opts...), tt.wantPtr)
+		})
+	}
+}
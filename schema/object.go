@@ -0,0 +1,247 @@
+package schema
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// ObjectSchema validates objects: decoded JSON objects, maps with string keys and structs.
+type ObjectSchema struct {
+	required bool
+	fields   map[string]Node
+	keys     []string
+}
+
+// Object returns a schema for objects whose keys are validated by the given nodes.
+// Keys without a node are ignored.
+func Object(fields map[string]Node) *ObjectSchema {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return &ObjectSchema{fields: fields, keys: keys}
+}
+
+// Required rejects missing and null objects.
+func (s *ObjectSchema) Required() *ObjectSchema {
+	s.required = true
+	return s
+}
+
+func (s *ObjectSchema) validate(p path, value interface{}, errs rapidval.ValidationErrors) rapidval.ValidationErrors {
+	value, isNil := indirect(value)
+	if isNil {
+		if s.required {
+			errs = append(errs, newError(p, rapidval.MsgRequired, nil, nil))
+		}
+		return errs
+	}
+
+	if m, ok := value.(map[string]interface{}); ok {
+		for _, k := range s.keys {
+			errs = s.fields[k].validate(p.key(k), m[k], errs)
+		}
+		return errs
+	}
+
+	rv := reflect.ValueOf(value)
+	switch {
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		for _, k := range s.keys {
+			var fv interface{}
+			if v := rv.MapIndex(reflect.ValueOf(k).Convert(rv.Type().Key())); v.IsValid() {
+				fv = v.Interface()
+			}
+			errs = s.fields[k].validate(p.key(k), fv, errs)
+		}
+	case rv.Kind() == reflect.Struct:
+		index := structFields(rv.Type())
+		for _, k := range s.keys {
+			var fv interface{}
+			if i, ok := index[k]; ok {
+				if f, ok := fieldByIndex(rv, i); ok {
+					fv = f.Interface()
+				}
+			}
+			errs = s.fields[k].validate(p.key(k), fv, errs)
+		}
+	default:
+		errs = append(errs, typeError(p, "object", value))
+	}
+	return errs
+}
+
+func (s *ObjectSchema) describe(field string, docs []FieldDoc) []FieldDoc {
+	if field != "" {
+		docs = append(docs, FieldDoc{Field: field, Type: "object", Required: s.required})
+	}
+	for _, k := range s.keys {
+		child := k
+		if field != "" {
+			child = field + "." + k
+		}
+		docs = s.fields[k].describe(child, docs)
+	}
+	return docs
+}
+
+var structFieldsCache sync.Map // map[reflect.Type]map[string][]int
+
+// structFields maps object keys to struct field index paths.
+// A field is reachable by its json tag name and by its Go field name. Fields of embedded structs are
+// promoted like encoding/json does, with fields at a shallower depth taking precedence.
+func structFields(t reflect.Type) map[string][]int {
+	if cached, ok := structFieldsCache.Load(t); ok {
+		return cached.(map[string][]int)
+	}
+	index := make(map[string][]int, t.NumField())
+	addStructFields(index, t, nil)
+	structFieldsCache.Store(t, index)
+	return index
+}
+
+func addStructFields(index map[string][]int, t reflect.Type, parent []int) {
+	var embedded []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, sf)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		path := append(parent[:len(parent):len(parent)], i)
+		for _, key := range []string{sf.Name, name} {
+			if key == "" || key == "-" {
+				continue
+			}
+			if cur, ok := index[key]; !ok || len(cur) > len(path) {
+				index[key] = path
+			}
+		}
+	}
+	// Promoted fields are added after the fields declared directly in t, so the latter take precedence.
+	for _, sf := range embedded {
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		addStructFields(index, ft, append(parent[:len(parent):len(parent)], sf.Index[0]))
+	}
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex, but reports false instead of panicking
+// when the path goes through a nil embedded pointer.
+func fieldByIndex(rv reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return rv, false
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(x)
+	}
+	return rv, true
+}
+
+// ArraySchema validates arrays: decoded JSON arrays, slices and Go arrays.
+type ArraySchema struct {
+	required bool
+	elem     Node
+	min, max int
+}
+
+// Array returns a schema for arrays whose elements are validated by elem.
+// A nil elem only validates the array itself.
+func Array(elem Node) *ArraySchema {
+	return &ArraySchema{elem: elem, min: -1, max: -1}
+}
+
+// Required rejects missing and null arrays.
+func (s *ArraySchema) Required() *ArraySchema {
+	s.required = true
+	return s
+}
+
+// Min requires the array to contain at least n elements. It fails with MsgMinItems.
+func (s *ArraySchema) Min(n int) *ArraySchema {
+	s.min = n
+	return s
+}
+
+// Max requires the array to contain at most n elements. It fails with MsgMaxItems.
+func (s *ArraySchema) Max(n int) *ArraySchema {
+	s.max = n
+	return s
+}
+
+func (s *ArraySchema) validate(p path, value interface{}, errs rapidval.ValidationErrors) rapidval.ValidationErrors {
+	value, isNil := indirect(value)
+	if isNil {
+		if s.required {
+			errs = append(errs, newError(p, rapidval.MsgRequired, nil, nil))
+		}
+		return errs
+	}
+
+	items, isSlice := value.([]interface{})
+	rv := reflect.ValueOf(value)
+	if !isSlice && rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return append(errs, typeError(p, "array", value))
+	}
+
+	n := rv.Len()
+	errs = s.checkLen(p, value, n, errs)
+	if s.elem == nil {
+		return errs
+	}
+	for i := 0; i < n; i++ {
+		var item interface{}
+		if isSlice {
+			item = items[i]
+		} else {
+			item = rv.Index(i).Interface()
+		}
+		errs = s.elem.validate(p.index(i), item, errs)
+	}
+	return errs
+}
+
+func (s *ArraySchema) checkLen(p path, value interface{}, n int, errs rapidval.ValidationErrors) rapidval.ValidationErrors {
+	if s.min >= 0 && n < s.min {
+		errs = append(errs, newError(p, rapidval.MsgMinItems, value, map[string]interface{}{rapidval.Min: s.min}))
+	}
+	if s.max >= 0 && n > s.max {
+		errs = append(errs, newError(p, rapidval.MsgMaxItems, value, map[string]interface{}{rapidval.Max: s.max}))
+	}
+	return errs
+}
+
+func (s *ArraySchema) describe(field string, docs []FieldDoc) []FieldDoc {
+	doc := FieldDoc{Field: field, Type: "array", Required: s.required}
+	if s.min >= 0 {
+		doc.Constraints = append(doc.Constraints, Constraint{MessageKey: rapidval.MsgMinItems, Params: map[string]interface{}{rapidval.Min: s.min}})
+	}
+	if s.max >= 0 {
+		doc.Constraints = append(doc.Constraints, Constraint{MessageKey: rapidval.MsgMaxItems, Params: map[string]interface{}{rapidval.Max: s.max}})
+	}
+	docs = append(docs, doc)
+	if s.elem == nil {
+		return docs
+	}
+	return s.elem.describe(field+"[]", docs)
+}
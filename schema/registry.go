@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"sort"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+	schemas map[string]*ObjectSchema
+}{schemas: map[string]*ObjectSchema{}}
+
+// Register makes a schema available under id, e.g. for lookups by the WebAssembly bridge.
+// Registering the same id twice replaces the previous schema.
+func Register(id string, s *ObjectSchema) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.schemas[id] = s
+}
+
+// Lookup returns the schema registered under id.
+func Lookup(id string) (*ObjectSchema, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	s, ok := registry.schemas[id]
+	return s, ok
+}
+
+// IDs returns the ids of all registered schemas in sorted order.
+func IDs() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	ids := make([]string, 0, len(registry.schemas))
+	for id := range registry.schemas {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
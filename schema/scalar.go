@@ -0,0 +1,227 @@
+package schema
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// StringSchema validates string values.
+type StringSchema struct {
+	required    bool
+	checks      []func(field, value string) *rapidval.ValidationError
+	constraints []Constraint
+}
+
+// String returns a schema for string values.
+func String() *StringSchema {
+	return &StringSchema{}
+}
+
+// Required rejects missing, null and empty strings.
+func (s *StringSchema) Required() *StringSchema {
+	s.required = true
+	return s
+}
+
+// Min requires the string to be at least n characters (runes) long, like the min tag of ValidateStruct.
+func (s *StringSchema) Min(n int) *StringSchema {
+	s.checks = append(s.checks, func(field, value string) *rapidval.ValidationError {
+		return rapidval.MinRunes(field, value, n)
+	})
+	s.constraints = append(s.constraints, Constraint{MessageKey: rapidval.MsgMinLength, Params: map[string]interface{}{rapidval.Min: n}})
+	return s
+}
+
+// Max requires the string to be at most n characters (runes) long, like the max tag of ValidateStruct.
+func (s *StringSchema) Max(n int) *StringSchema {
+	s.checks = append(s.checks, func(field, value string) *rapidval.ValidationError {
+		return rapidval.MaxRunes(field, value, n)
+	})
+	s.constraints = append(s.constraints, Constraint{MessageKey: rapidval.MsgMaxLength, Params: map[string]interface{}{rapidval.Max: n}})
+	return s
+}
+
+// Email requires the string to be an email address.
+func (s *StringSchema) Email() *StringSchema {
+	s.checks = append(s.checks, rapidval.Email)
+	s.constraints = append(s.constraints, Constraint{MessageKey: rapidval.MsgInvalidEmail})
+	return s
+}
+
+// OneOf requires the string to be one of the allowed values.
+func (s *StringSchema) OneOf(allowed ...string) *StringSchema {
+	s.checks = append(s.checks, func(field, value string) *rapidval.ValidationError {
+		return rapidval.OneOf(field, value, allowed...)
+	})
+	s.constraints = append(s.constraints, Constraint{MessageKey: rapidval.MsgOneOf, Params: map[string]interface{}{rapidval.Allowed: allowed}})
+	return s
+}
+
+func (s *StringSchema) validate(p path, value interface{}, errs rapidval.ValidationErrors) rapidval.ValidationErrors {
+	value, isNil := indirect(value)
+	if isNil {
+		if s.required {
+			errs = append(errs, newError(p, rapidval.MsgRequired, nil, nil))
+		}
+		return errs
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.String {
+			return append(errs, typeError(p, "string", value))
+		}
+		str = rv.String()
+	}
+	if str == "" {
+		if s.required {
+			errs = append(errs, newError(p, rapidval.MsgRequired, str, nil))
+		}
+		return errs
+	}
+
+	field := p.String()
+	for _, check := range s.checks {
+		if err := check(field, str); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (s *StringSchema) describe(field string, docs []FieldDoc) []FieldDoc {
+	return append(docs, FieldDoc{Field: field, Type: "string", Required: s.required, Constraints: s.constraints})
+}
+
+// NumberSchema validates numeric values.
+// Values are compared as float64; JSON numbers and all Go integer and float types are accepted.
+type NumberSchema struct {
+	required bool
+	integer  bool
+	min, max *float64
+}
+
+// Number returns a schema for numeric values.
+func Number() *NumberSchema {
+	return &NumberSchema{}
+}
+
+// Required rejects missing and null values. Zero is a valid number.
+func (s *NumberSchema) Required() *NumberSchema {
+	s.required = true
+	return s
+}
+
+// Integer rejects numbers with a fractional part.
+func (s *NumberSchema) Integer() *NumberSchema {
+	s.integer = true
+	return s
+}
+
+// Min requires the number to be greater than or equal to min.
+func (s *NumberSchema) Min(min float64) *NumberSchema {
+	s.min = &min
+	return s
+}
+
+// Max requires the number to be less than or equal to max.
+func (s *NumberSchema) Max(max float64) *NumberSchema {
+	s.max = &max
+	return s
+}
+
+func (s *NumberSchema) validate(p path, value interface{}, errs rapidval.ValidationErrors) rapidval.ValidationErrors {
+	value, isNil := indirect(value)
+	if isNil {
+		if s.required {
+			errs = append(errs, newError(p, rapidval.MsgRequired, nil, nil))
+		}
+		return errs
+	}
+
+	n, ok := toFloat(value)
+	if !ok || (s.integer && n != math.Trunc(n)) {
+		typ := "number"
+		if s.integer {
+			typ = "integer"
+		}
+		return append(errs, typeError(p, typ, value))
+	}
+	if s.min != nil && n < *s.min {
+		errs = append(errs, newError(p, rapidval.MsgMin, value, map[string]interface{}{rapidval.Min: *s.min}))
+	}
+	if s.max != nil && n > *s.max {
+		errs = append(errs, newError(p, rapidval.MsgMax, value, map[string]interface{}{rapidval.Max: *s.max}))
+	}
+	return errs
+}
+
+func (s *NumberSchema) describe(field string, docs []FieldDoc) []FieldDoc {
+	doc := FieldDoc{Field: field, Type: "number", Required: s.required}
+	if s.integer {
+		doc.Type = "integer"
+	}
+	if s.min != nil {
+		doc.Constraints = append(doc.Constraints, Constraint{MessageKey: rapidval.MsgMin, Params: map[string]interface{}{rapidval.Min: *s.min}})
+	}
+	if s.max != nil {
+		doc.Constraints = append(doc.Constraints, Constraint{MessageKey: rapidval.MsgMax, Params: map[string]interface{}{rapidval.Max: *s.max}})
+	}
+	return append(docs, doc)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// BoolSchema validates boolean values.
+type BoolSchema struct {
+	required bool
+}
+
+// Bool returns a schema for boolean values.
+func Bool() *BoolSchema {
+	return &BoolSchema{}
+}
+
+// Required rejects missing and null values. False is a valid boolean.
+func (s *BoolSchema) Required() *BoolSchema {
+	s.required = true
+	return s
+}
+
+func (s *BoolSchema) validate(p path, value interface{}, errs rapidval.ValidationErrors) rapidval.ValidationErrors {
+	value, isNil := indirect(value)
+	if isNil {
+		if s.required {
+			errs = append(errs, newError(p, rapidval.MsgRequired, nil, nil))
+		}
+		return errs
+	}
+	if reflect.ValueOf(value).Kind() != reflect.Bool {
+		errs = append(errs, typeError(p, "boolean", value))
+	}
+	return errs
+}
+
+func (s *BoolSchema) describe(field string, docs []FieldDoc) []FieldDoc {
+	return append(docs, FieldDoc{Field: field, Type: "boolean", Required: s.required})
+}
@@ -0,0 +1,109 @@
+// Package schema provides a builder DSL for declaring validation rules at runtime,
+// independently of Go types.
+//
+// Schemas validate decoded JSON (map[string]interface{}, []interface{}, float64, string, bool)
+// as well as structs, which are mapped to object keys by their json tag or field name:
+//
+//	signup := schema.Object(map[string]schema.Node{
+//	    "name":  schema.String().Required().Min(2),
+//	    "email": schema.String().Required().Email(),
+//	    "age":   schema.Number().Integer().Min(18),
+//	    "tags":  schema.Array(schema.String().Max(20)).Max(5),
+//	})
+//
+//	err := schema.Validate(signup, payload)
+//
+// Errors use the same ValidationError/MessageKey machinery as the rest of rapidval;
+// nested fields are reported as "address.city" and array elements as "tags[2]".
+package schema
+
+import (
+	"reflect"
+
+	"github.com/9ssi7/rapidval"
+)
+
+// Node is a schema node that validates a single value.
+type Node interface {
+	validate(p path, value interface{}, errs rapidval.ValidationErrors) rapidval.ValidationErrors
+	describe(field string, docs []FieldDoc) []FieldDoc
+}
+
+// Validate validates value against the node and returns ValidationErrors, or nil if the value is valid.
+// Options such as MaxDepth are checked first; a document exceeding a limit yields a single error
+// for the location where the limit was exceeded and is not validated further.
+func Validate(n Node, value interface{}, opts ...Option) error {
+	if l := newLimits(opts); l.enabled() {
+		w := limitWalker{limits: l}
+		if err := w.walk(path{}, 0, value); err != nil {
+			return rapidval.ValidationErrors{err}
+		}
+	}
+	if errs := n.validate(path{}, value, nil); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// path is the location of a value inside the validated document.
+type path struct {
+	segments rapidval.Path
+	// pointer renders the path as a JSON Pointer instead of the dotted form.
+	pointer bool
+}
+
+func (p path) key(k string) path {
+	p.segments = append(p.segments[:len(p.segments):len(p.segments)], rapidval.Segment{Name: k})
+	return p
+}
+
+func (p path) index(i int) path {
+	p.segments = append(p.segments[:len(p.segments):len(p.segments)], rapidval.Segment{Index: i, HasIndex: true})
+	return p
+}
+
+// String returns the dotted form of the path, e.g. "items[2].price",
+// or its JSON Pointer form, e.g. "/items/2/price".
+func (p path) String() string {
+	if p.pointer {
+		return p.segments.JSONPointer()
+	}
+	return p.segments.String()
+}
+
+func newError(p path, key string, value interface{}, params map[string]interface{}) *rapidval.ValidationError {
+	field := p.String()
+	if params == nil {
+		params = make(map[string]interface{}, 2)
+	}
+	params[rapidval.Field] = field
+	params[rapidval.Value] = value
+	return &rapidval.ValidationError{
+		Field:         field,
+		MessageKey:    key,
+		MessageParams: params,
+		CurrentValue:  value,
+	}
+}
+
+func typeError(p path, typ string, value interface{}) *rapidval.ValidationError {
+	return newError(p, rapidval.MsgInvalidType, value, map[string]interface{}{rapidval.Type: typ})
+}
+
+// indirect dereferences pointers and reports whether the value is nil.
+func indirect(value interface{}) (interface{}, bool) {
+	if value == nil {
+		return nil, true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr {
+		return value, false
+	}
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, true
+		}
+		rv = rv.Elem()
+	}
+	return rv.Interface(), false
+}
@@ -0,0 +1,234 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+func signupSchema() *ObjectSchema {
+	return Object(map[string]Node{
+		"name":  String().Required().Min(2).Max(20),
+		"email": String().Required().Email(),
+		"role":  String().OneOf("admin", "user"),
+		"age":   Number().Required().Integer().Min(18).Max(100),
+		"terms": Bool().Required(),
+		"address": Object(map[string]Node{
+			"city": String().Required(),
+		}).Required(),
+		"tags": Array(String().Max(5)).Max(2),
+	})
+}
+
+func errorKeys(t *testing.T, err error) map[string]string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	verr, ok := err.(rapidval.ValidationErrors)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	keys := make(map[string]string, len(verr))
+	for _, e := range verr {
+		keys[e.Field] = e.MessageKey
+	}
+	return keys
+}
+
+func TestValidateDecodedJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want map[string]string
+	}{
+		{
+			name: "valid payload",
+			json: `{"name":"John","email":"john@example.com","role":"admin","age":30,"terms":false,"address":{"city":"Istanbul"},"tags":["go"]}`,
+		},
+		{
+			name: "missing fields",
+			json: `{}`,
+			want: map[string]string{
+				"name":    rapidval.MsgRequired,
+				"email":   rapidval.MsgRequired,
+				"age":     rapidval.MsgRequired,
+				"terms":   rapidval.MsgRequired,
+				"address": rapidval.MsgRequired,
+			},
+		},
+		{
+			name: "invalid values",
+			json: `{"name":"J","email":"invalid","role":"guest","age":17.5,"terms":"yes","address":{"city":""},"tags":["golang",1,"x"]}`,
+			want: map[string]string{
+				"name":         rapidval.MsgMinLength,
+				"email":        rapidval.MsgInvalidEmail,
+				"role":         rapidval.MsgOneOf,
+				"age":          rapidval.MsgInvalidType,
+				"terms":        rapidval.MsgInvalidType,
+				"address.city": rapidval.MsgRequired,
+				"tags":         rapidval.MsgMaxItems,
+				"tags[0]":      rapidval.MsgMaxLength,
+				"tags[1]":      rapidval.MsgInvalidType,
+			},
+		},
+		{
+			name: "out of range number",
+			json: `{"name":"John","email":"john@example.com","age":101,"terms":true,"address":{"city":"Izmir"}}`,
+			want: map[string]string{"age": rapidval.MsgMax},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var payload interface{}
+			if err := json.Unmarshal([]byte(tt.json), &payload); err != nil {
+				t.Fatal(err)
+			}
+			got := errorKeys(t, Validate(signupSchema(), payload))
+			if len(got) != len(tt.want) {
+				t.Errorf("got errors %v, want %v", got, tt.want)
+			}
+			for field, key := range tt.want {
+				if got[field] != key {
+					t.Errorf("field %s: got %v, want %v", field, got[field], key)
+				}
+			}
+		})
+	}
+}
+
+type address struct {
+	City string `json:"city"`
+}
+
+type signup struct {
+	Name    string   `json:"name"`
+	Email   string   `json:"email"`
+	Role    string   `json:"role"`
+	Age     int      `json:"age"`
+	Terms   *bool    `json:"terms"`
+	Address *address `json:"address"`
+	Tags    []string `json:"tags"`
+}
+
+func TestStringLengthCountsRunes(t *testing.T) {
+	name := String().Min(4).Max(4)
+	if err := Validate(name, "Işık"); err != nil {
+		t.Errorf("Validate(Işık) = %v, want nil", err)
+	}
+	errs, _ := Validate(name, "Çağ").(rapidval.ValidationErrors)
+	if len(errs) != 1 || errs[0].MessageKey != rapidval.MsgMinLength {
+		t.Errorf("Validate(Çağ) = %v, want %s", errs, rapidval.MsgMinLength)
+	}
+}
+
+func TestValidateStruct(t *testing.T) {
+	yes := true
+	valid := signup{
+		Name:    "John",
+		Email:   "john@example.com",
+		Age:     30,
+		Terms:   &yes,
+		Address: &address{City: "Istanbul"},
+		Tags:    []string{"go"},
+	}
+	if err := Validate(signupSchema(), &valid); err != nil {
+		t.Errorf("Validate() returned error: %v", err)
+	}
+
+	invalid := valid
+	invalid.Age = 10
+	invalid.Terms = nil
+	invalid.Address = &address{}
+	invalid.Tags = []string{"golang"}
+	got := errorKeys(t, Validate(signupSchema(), invalid))
+	want := map[string]string{
+		"age":          rapidval.MsgMin,
+		"terms":        rapidval.MsgRequired,
+		"address.city": rapidval.MsgRequired,
+		"tags[0]":      rapidval.MsgMaxLength,
+	}
+	if len(got) != len(want) {
+		t.Errorf("got errors %v, want %v", got, want)
+	}
+	for field, key := range want {
+		if got[field] != key {
+			t.Errorf("field %s: got %v, want %v", field, got[field], key)
+		}
+	}
+}
+
+type audit struct {
+	CreatedBy string `json:"created_by"`
+}
+
+type location struct {
+	audit
+	City string `json:"city"`
+}
+
+type shop struct {
+	*location
+	Name string `json:"name"`
+	City string `json:"-"`
+}
+
+func TestValidateEmbeddedStruct(t *testing.T) {
+	s := Object(map[string]Node{
+		"name":       String().Required(),
+		"city":       String().Required(),
+		"created_by": String().Required(),
+	})
+
+	got := errorKeys(t, Validate(s, shop{location: &location{}, City: "shadowed"}))
+	want := map[string]string{
+		"name":       rapidval.MsgRequired,
+		"city":       rapidval.MsgRequired,
+		"created_by": rapidval.MsgRequired,
+	}
+	if len(got) != len(want) {
+		t.Errorf("got errors %v, want %v", got, want)
+	}
+
+	valid := shop{location: &location{audit: audit{CreatedBy: "admin"}, City: "Istanbul"}, Name: "Corner Shop"}
+	if err := Validate(s, valid); err != nil {
+		t.Errorf("Validate() returned error: %v", err)
+	}
+
+	got = errorKeys(t, Validate(s, shop{Name: "Corner Shop"}))
+	if got["city"] != rapidval.MsgRequired || got["created_by"] != rapidval.MsgRequired {
+		t.Errorf("nil embedded pointer: got %v, want required errors", got)
+	}
+}
+
+func TestValidateTypeMismatch(t *testing.T) {
+	got := errorKeys(t, Validate(Object(nil).Required(), "text"))
+	if got[""] != rapidval.MsgInvalidType {
+		t.Errorf("got %v, want type error", got)
+	}
+}
+
+func BenchmarkValidate(b *testing.B) {
+	var payload interface{}
+	json.Unmarshal([]byte(`{"name":"John","email":"john@example.com","role":"admin","age":30,"terms":false,"address":{"city":"Istanbul"},"tags":["go"]}`), &payload)
+	s := signupSchema()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Validate(s, payload)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	s := signupSchema()
+	Register("signup", s)
+
+	got, ok := Lookup("signup")
+	if !ok || got != s {
+		t.Errorf("Lookup() = %v, %v, want registered schema", got, ok)
+	}
+	if _, ok := Lookup("unknown"); ok {
+		t.Error("Lookup() should not find unregistered schemas")
+	}
+}
@@ -0,0 +1,149 @@
+package schema
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/9ssi7/rapidval"
+)
+
+type paramKind int
+
+const (
+	stringParam paramKind = iota
+	intParam
+	floatParam
+	boolParam
+)
+
+var paramKindNames = [...]string{
+	stringParam: "string",
+	intParam:    "integer",
+	floatParam:  "number",
+	boolParam:   "boolean",
+}
+
+// ParamSchema describes a single url.Values parameter.
+type ParamSchema struct {
+	kind     paramKind
+	node     Node
+	required bool
+	maxCount int
+}
+
+// StringParam returns a parameter whose values are validated as strings by n.
+// A nil n only checks presence and count.
+func StringParam(n Node) *ParamSchema {
+	return &ParamSchema{kind: stringParam, node: n, maxCount: 1}
+}
+
+// IntParam returns a parameter whose values must parse as integers and are then validated by n.
+func IntParam(n Node) *ParamSchema {
+	return &ParamSchema{kind: intParam, node: n, maxCount: 1}
+}
+
+// FloatParam returns a parameter whose values must parse as numbers and are then validated by n.
+func FloatParam(n Node) *ParamSchema {
+	return &ParamSchema{kind: floatParam, node: n, maxCount: 1}
+}
+
+// BoolParam returns a parameter whose values must parse with strconv.ParseBool.
+func BoolParam() *ParamSchema {
+	return &ParamSchema{kind: boolParam, maxCount: 1}
+}
+
+// Required rejects requests where the parameter is missing or has only empty values.
+func (p *ParamSchema) Required() *ParamSchema {
+	p.required = true
+	return p
+}
+
+// Repeated allows the parameter to appear up to max times, e.g. "?tag=a&tag=b".
+func (p *ParamSchema) Repeated(max int) *ParamSchema {
+	p.maxCount = max
+	return p
+}
+
+// ValuesSchema validates url.Values such as query strings and form bodies.
+type ValuesSchema struct {
+	params map[string]*ParamSchema
+	keys   []string
+}
+
+// Values returns a schema for url.Values. Parameters without a schema are ignored.
+func Values(params map[string]*ParamSchema) *ValuesSchema {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return &ValuesSchema{params: params, keys: keys}
+}
+
+// Names returns the names of the parameters described by the schema, in sorted order.
+func (s *ValuesSchema) Names() []string {
+	return s.keys
+}
+
+// Validate validates values and returns errors keyed by parameter name.
+func (s *ValuesSchema) Validate(values url.Values) rapidval.ValidationErrors {
+	var errs rapidval.ValidationErrors
+	for _, name := range s.keys {
+		errs = s.params[name].validate(name, values[name], errs)
+	}
+	return errs
+}
+
+func (p *ParamSchema) validate(name string, values []string, errs rapidval.ValidationErrors) rapidval.ValidationErrors {
+	pth := path{}.key(name)
+
+	present := false
+	for _, v := range values {
+		if v != "" {
+			present = true
+			break
+		}
+	}
+	if !present {
+		if p.required {
+			errs = append(errs, newError(pth, rapidval.MsgRequired, "", nil))
+		}
+		return errs
+	}
+
+	if p.maxCount > 0 && len(values) > p.maxCount {
+		errs = append(errs, newError(pth, rapidval.MsgMaxCount, values, map[string]interface{}{rapidval.Max: p.maxCount}))
+		return errs
+	}
+
+	for _, raw := range values {
+		if raw == "" && p.kind != stringParam {
+			continue
+		}
+		value, ok := p.parse(raw)
+		if !ok {
+			errs = append(errs, typeError(pth, paramKindNames[p.kind], raw))
+			continue
+		}
+		if p.node != nil {
+			errs = p.node.validate(pth, value, errs)
+		}
+	}
+	return errs
+}
+
+func (p *ParamSchema) parse(raw string) (interface{}, bool) {
+	switch p.kind {
+	case intParam:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		return n, err == nil
+	case floatParam:
+		f, err := strconv.ParseFloat(raw, 64)
+		return f, err == nil
+	case boolParam:
+		b, err := strconv.ParseBool(raw)
+		return b, err == nil
+	}
+	return raw, true
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/9ssi7/rapidval"
+)
+
+func searchSchema() *ValuesSchema {
+	return Values(map[string]*ParamSchema{
+		"q":       StringParam(String().Max(10)).Required(),
+		"page":    IntParam(Number().Min(1)),
+		"price":   FloatParam(Number().Max(100)),
+		"instock": BoolParam(),
+		"tag":     StringParam(String().OneOf("go", "rust", "zig")).Repeated(2),
+	})
+}
+
+func TestValuesSchema(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  map[string]string
+	}{
+		{
+			name:  "valid query",
+			query: "q=shoes&page=2&price=9.5&instock=true&tag=go&tag=zig&unknown=1",
+		},
+		{
+			name:  "missing required",
+			query: "q=&page=",
+			want:  map[string]string{"q": rapidval.MsgRequired},
+		},
+		{
+			name:  "typed params",
+			query: "q=shoes&page=two&price=1e3&instock=maybe",
+			want: map[string]string{
+				"page":    rapidval.MsgInvalidType,
+				"price":   rapidval.MsgMax,
+				"instock": rapidval.MsgInvalidType,
+			},
+		},
+		{
+			name:  "repeated params",
+			query: "q=shoes&q=boots&tag=go&tag=rust&tag=zig",
+			want: map[string]string{
+				"q":   rapidval.MsgMaxCount,
+				"tag": rapidval.MsgMaxCount,
+			},
+		},
+		{
+			name:  "repeated value rules",
+			query: "q=shoes&tag=go&tag=java",
+			want:  map[string]string{"tag": rapidval.MsgOneOf},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			errs := searchSchema().Validate(values)
+			got := make(map[string]string, len(errs))
+			for _, e := range errs {
+				got[e.Field] = e.MessageKey
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("got errors %v, want %v", got, tt.want)
+			}
+			for field, key := range tt.want {
+				if got[field] != key {
+					t.Errorf("param %s: got %v, want %v", field, got[field], key)
+				}
+			}
+		})
+	}
+}
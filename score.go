@@ -0,0 +1,111 @@
+package rapidval
+
+import "context"
+
+// WithScoring makes Run score each result by the weight of the rules that passed, for data-quality
+// pipelines that accept records above a threshold instead of only valid ones:
+//
+//	v := rapidval.New(rapidval.WithScoring())
+//	if res := v.Run(record); res.Score() >= 0.8 {
+//	    accept(record)
+//	}
+//
+// Every rule weighs 1 unless it is wrapped in Weight. Rules wrapped in Warn count as well, and
+// rules skipped by When, SkipUnless or Chain do not count at all. Validate does not score.
+func WithScoring() Option {
+	return func(v *Validator) {
+		v.scoring = true
+	}
+}
+
+// Weight groups rules that weigh weight instead of 1 in the Score of a Validator created with
+// WithScoring, e.g. to let a missing email cost more than a badly formatted phone number:
+//
+//	rapidval.Weight(5, rapidval.Required("Email", c.Email))
+//
+// Nested groups take the weight of the innermost Weight. Weight has no effect on validation itself.
+func Weight(weight float64, rules ...Rule) Rule {
+	return weightRule{weight: weight, rules: rules}
+}
+
+type weightRule struct {
+	weight float64
+	rules  []Rule
+}
+
+// Check implements Rule for callers evaluating the rule on its own. It returns the first error only.
+func (w weightRule) Check(ctx context.Context) *ValidationError {
+	if errs := w.collect(ctx, &Validator{}, "", fieldPrefix{}, nil); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (w weightRule) collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	s := v.scoreOf(ctx)
+	if s == nil {
+		return v.collectRules(ctx, name, w.rules, prefix, errs)
+	}
+	parent := s.weight
+	s.weight = w.weight
+	errs = v.collectRules(ctx, name, w.rules, prefix, errs)
+	s.weight = parent
+	return errs
+}
+
+type scoreKey struct{}
+
+// score accumulates the weights of the rules evaluated during one call to Run.
+type score struct {
+	weight float64 // weight of the rules being evaluated
+
+	passed, total float64
+}
+
+// scoreOf returns the score of the current call, or nil if v does not score.
+func (v *Validator) scoreOf(ctx context.Context) *score {
+	if !v.scoring {
+		return nil
+	}
+	s, _ := ctx.Value(scoreKey{}).(*score)
+	return s
+}
+
+// scored runs run with a score in its context if v scores, and returns its result.
+func (v *Validator) scored(ctx context.Context, run func(ctx context.Context) (errs, warnings ValidationErrors)) Result {
+	if !v.scoring {
+		errs, warnings := run(ctx)
+		return Result{errs: errs, warnings: warnings}
+	}
+	s := &score{weight: 1}
+	errs, warnings := run(context.WithValue(ctx, scoreKey{}, s))
+	return Result{errs: errs, warnings: warnings, score: s}
+}
+
+// scoreRule records the outcome of a rule in the score of the current call.
+func (v *Validator) scoreRule(ctx context.Context, failed bool) {
+	s := v.scoreOf(ctx)
+	if s == nil {
+		return
+	}
+	s.total += s.weight
+	if !failed {
+		s.passed += s.weight
+	}
+}
+
+// Score returns the weight of the rules that passed as a fraction of the weight of all rules that
+// were evaluated, from 0 to 1, for a Validator created with WithScoring. A result without rules
+// scores 1. Without WithScoring, Score is 1 for valid results and 0 otherwise.
+func (r Result) Score() float64 {
+	switch {
+	case r.score == nil:
+		if r.Valid() {
+			return 1
+		}
+		return 0
+	case r.score.total == 0:
+		return 1
+	}
+	return r.score.passed / r.score.total
+}
@@ -0,0 +1,58 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+)
+
+type qualityRecord struct {
+	Email   string
+	Phone   string
+	Country string
+}
+
+func (r *qualityRecord) Validations() P {
+	return P{
+		Weight(4,
+			Required("Email", r.Email),
+			Weight(2, Email("Email", r.Email)),
+		),
+		Required("Phone", r.Phone),
+		Warn(MinLength("Country", r.Country, 2)),
+		When(r.Country == "TR", RuleFunc(func(ctx context.Context) *ValidationError {
+			return &ValidationError{Field: "Phone", MessageKey: "custom.region"}
+		})),
+	}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name   string
+		record qualityRecord
+		opts   []Option
+		want   float64
+	}{
+		{"all pass", qualityRecord{Email: "a@example.com", Phone: "5551234", Country: "DE"}, []Option{WithScoring()}, 1},
+		{"weighted failures", qualityRecord{Phone: "5551234", Country: "DE"}, []Option{WithScoring()}, 2.0 / 8},
+		{"warning counts", qualityRecord{Email: "a@example.com", Phone: "5551234"}, []Option{WithScoring()}, 7.0 / 8},
+		{"lazy rule counts", qualityRecord{Email: "a@example.com", Country: "TR"}, []Option{WithScoring()}, 7.0 / 9},
+		{"unscored valid", qualityRecord{Email: "a@example.com", Phone: "5551234", Country: "DE"}, nil, 1},
+		{"unscored invalid", qualityRecord{Email: "a@example.com", Country: "DE"}, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.opts...).Run(&tt.record).Score(); got != tt.want {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := New(WithScoring()).Run(&struct{ emptyValidations }{}).Score(); got != 1 {
+		t.Errorf("Score() without rules = %v, want 1", got)
+	}
+}
+
+type emptyValidations struct{}
+
+func (emptyValidations) Validations() P { return nil }
@@ -0,0 +1,55 @@
+package rapidval
+
+import "context"
+
+// Redacted replaces the values of errors produced by rules wrapped in Sensitive.
+const Redacted = "[REDACTED]"
+
+// Sensitive groups rules on secret values such as passwords and tokens. The errors they produce
+// carry Redacted instead of the value in CurrentValue and in the Value and Token params, so the value
+// cannot leak into logs or responses:
+//
+//	rapidval.Sensitive(
+//	    rapidval.MinLength("Password", u.Password, 12),
+//	)
+func Sensitive(rules ...Rule) Rule {
+	return sensitiveRule(rules)
+}
+
+type sensitiveRule []Rule
+
+// Check implements Rule for callers evaluating the rule on its own. It returns the first error only.
+func (s sensitiveRule) Check(ctx context.Context) *ValidationError {
+	if errs := s.collect(ctx, &Validator{}, "", fieldPrefix{}, nil); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// collect evaluates the rules with a context marked as sensitive, so their errors are redacted as
+// soon as they are produced, before trace functions see them.
+func (s sensitiveRule) collect(ctx context.Context, v *Validator, name string, prefix fieldPrefix, errs ValidationErrors) ValidationErrors {
+	return v.collectRules(context.WithValue(ctx, sensitiveKey{}, true), name, s, prefix, errs)
+}
+
+type sensitiveKey struct{}
+
+// sensitive reports whether ctx belongs to rules wrapped in Sensitive.
+func sensitive(ctx context.Context) bool {
+	s, _ := ctx.Value(sensitiveKey{}).(bool)
+	return s
+}
+
+// inputParams are the message params that echo the validated input: the value itself, and the
+// offending part of it reported by rules such as SortExpr, Filter and FieldMask.
+var inputParams = [...]string{Value, Token}
+
+// redact replaces the value of the error, and every param echoing it, with Redacted.
+func (ve *ValidationError) redact() {
+	ve.CurrentValue = Redacted
+	for _, k := range inputParams {
+		if _, ok := ve.MessageParams[k]; ok {
+			ve.MessageParams[k] = Redacted
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package rapidval
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type loginForm struct {
+	Username string
+	Password string
+}
+
+func (l *loginForm) Validations() P {
+	return P{
+		MinLength("Username", l.Username, 3),
+		Sensitive(
+			MinLength("Password", l.Password, 12),
+		),
+	}
+}
+
+func TestSensitive(t *testing.T) {
+	err := New().Validate(&loginForm{Username: "al", Password: "hunter2"})
+	verr, ok := err.(ValidationErrors)
+	if !ok || len(verr) != 2 {
+		t.Fatalf("Validate() = %v, want 2 errors", err)
+	}
+
+	if verr[0].CurrentValue != "al" {
+		t.Errorf("Username value = %v, want it unredacted", verr[0].CurrentValue)
+	}
+	password := verr[1]
+	if password.CurrentValue != Redacted || password.MessageParams[Value] != Redacted {
+		t.Errorf("Password value = %v / %v, want %v", password.CurrentValue, password.MessageParams[Value], Redacted)
+	}
+	if password.MessageParams[Min] != 12 {
+		t.Errorf("Password params = %v, want Min to be kept", password.MessageParams)
+	}
+
+	if out := fmt.Sprintf("%v %+v", err, password.MessageParams); strings.Contains(out, "hunter2") {
+		t.Errorf("formatted output leaks the value: %s", out)
+	}
+}
+
+func TestSensitiveTrace(t *testing.T) {
+	var values []interface{}
+	v := New(WithTraceFunc(func(e TraceEvent) {
+		if e.Err != nil {
+			values = append(values, e.Err.CurrentValue, e.Err.MessageParams[Value])
+		}
+	}))
+	_ = v.Validate(&loginForm{Username: "alice", Password: "hunter2"})
+
+	if len(values) != 2 || values[0] != Redacted || values[1] != Redacted {
+		t.Errorf("traced values = %v, want %v", values, Redacted)
+	}
+}
+
+func TestSensitiveToken(t *testing.T) {
+	ctx := context.Background()
+	for name, rule := range map[string]Rule{
+		"field mask": FieldMask("Mask", []string{"name", "secret_token"}, TreeSpec{"name": nil}),
+		"sort":       SortExpr("Sort", "name,-api_key", []string{"name"}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := Sensitive(rule).Check(ctx)
+			if err == nil {
+				t.Fatal("Check() = nil, want an error")
+			}
+			for k, v := range err.MessageParams {
+				if s, ok := v.(string); ok && k != Field && s != Redacted {
+					t.Errorf("param %s = %q, want %q", k, s, Redacted)
+				}
+			}
+			if err.MessageParams[Token] != Redacted {
+				t.Errorf("Token = %v, want %v", err.MessageParams[Token], Redacted)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package rapidval
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+)
+
+// Number is the set of integer and floating-point types accepted by the numeric generic rules.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// SumAtMost validates that the values add up to at most limit, e.g. for the quantities of order lines
+// or budget allocations in minor units. A sum that overflows T is reported too, with the values
+// instead of the sum as the value of the error, since it cannot be compared with limit.
+func SumAtMost[T Number](field string, values []T, limit T) *ValidationError {
+	var sum, zero T
+	for _, v := range values {
+		next := sum + v
+		if v > zero && next < sum || v < zero && next > sum {
+			err := newError(field, MsgSumAtMost, values)
+			err.MessageParams[Max] = limit
+			return err
+		}
+		sum = next
+	}
+	if sum > limit {
+		err := newError(field, MsgSumAtMost, sum)
+		err.MessageParams[Max] = limit
+		return err
+	}
+	return nil
+}
+
+// Ascending validates that the values are in non-decreasing order. The first element that is smaller than
+// the one before it is reported with its index, e.g. "Steps[3]".
+func Ascending[T cmp.Ordered](field string, values []T) *ValidationError {
+	for i := 1; i < len(values); i++ {
+		if values[i] < values[i-1] {
+			return newError(indexed(field, i), MsgAscending, values[i])
+		}
+	}
+	return nil
+}
+
+// Range is a half-open interval [Start, End) of ordered values.
+type Range[T cmp.Ordered] struct {
+	Start, End T
+}
+
+// NoOverlappingRanges validates that no two ranges overlap. Ranges are half-open, so a range may start
+// where another one ends. Of two overlapping ranges, the one starting later is reported with its index,
+// e.g. "Slots[2]", and the index of the other is available as the Token param.
+func NoOverlappingRanges[T cmp.Ordered](field string, ranges []Range[T]) *ValidationError {
+	earlier, later, found := firstOverlap(len(ranges),
+		func(i int) T { return ranges[i].Start },
+		func(i int) T { return ranges[i].End },
+		cmp.Compare[T])
+	if found {
+		return overlapError(field, earlier, later, ranges[later])
+	}
+	return nil
+}
+
+// firstOverlap sorts n half-open ranges by their start and returns the first pair, by original index,
+// in which the later range starts before the earlier one ends.
+func firstOverlap[T any](n int, start, end func(i int) T, compare func(a, b T) int) (earlier, later int, found bool) {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int { return compare(start(i), start(j)) })
+	// last is the range seen so far that ends last, the one a following range overlaps if any does.
+	last := -1
+	for _, i := range order {
+		if last >= 0 && compare(start(i), end(last)) < 0 {
+			return last, i, true
+		}
+		if last < 0 || compare(end(i), end(last)) > 0 {
+			last = i
+		}
+	}
+	return 0, 0, false
+}
+
+func overlapError(field string, earlier, later int, value interface{}) *ValidationError {
+	err := newError(indexed(field, later), MsgOverlap, value)
+	err.MessageParams[Token] = earlier
+	return err
+}
+
+func indexed(field string, i int) string {
+	return field + "[" + strconv.Itoa(i) + "]"
+}
@@ -0,0 +1,96 @@
+package rapidval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSumAtMost(t *testing.T) {
+	if err := SumAtMost("Allocations", []int64{4000, 5000, 1000}, 10000); err != nil {
+		t.Errorf("SumAtMost() = %v, want nil", err)
+	}
+	if err := SumAtMost("Allocations", []int64(nil), 0); err != nil {
+		t.Errorf("SumAtMost(nil) = %v, want nil", err)
+	}
+	err := SumAtMost("Weights", []float64{0.5, 0.25, 0.5}, 1)
+	if err == nil || err.MessageKey != MsgSumAtMost || err.CurrentValue != 1.25 || err.MessageParams[Max] != 1.0 {
+		t.Errorf("SumAtMost() = %+v, want a sum of 1.25 over 1", err)
+	}
+
+	overflows := []struct {
+		name string
+		err  *ValidationError
+	}{
+		{"int8", SumAtMost("Quantities", []int8{100, 100, -100}, 127)},
+		{"int8 negative", SumAtMost("Quantities", []int8{-100, -100}, 0)},
+		{"uint8", SumAtMost("Quantities", []uint8{200, 100}, 250)},
+		{"int64", SumAtMost("Quantities", []int64{math.MaxInt64, 1}, math.MaxInt64)},
+	}
+	for _, tt := range overflows {
+		if tt.err == nil || tt.err.MessageKey != MsgSumAtMost {
+			t.Errorf("%s: SumAtMost() = %v, want an overflow error", tt.name, tt.err)
+		}
+	}
+}
+
+func TestAscending(t *testing.T) {
+	tests := []struct {
+		values    []int
+		wantField string
+	}{
+		{nil, ""},
+		{[]int{1}, ""},
+		{[]int{1, 2, 2, 5}, ""},
+		{[]int{1, 3, 2, 5}, "Steps[2]"},
+		{[]int{5, 1}, "Steps[1]"},
+	}
+
+	for _, tt := range tests {
+		err := Ascending("Steps", tt.values)
+		if tt.wantField == "" {
+			if err != nil {
+				t.Errorf("Ascending(%v) = %v, want nil", tt.values, err)
+			}
+			continue
+		}
+		if err == nil || err.Field != tt.wantField || err.MessageKey != MsgAscending {
+			t.Errorf("Ascending(%v) = %+v, want an error on %s", tt.values, err, tt.wantField)
+		}
+	}
+
+	if err := Ascending("Names", []string{"a", "b", "a"}); err == nil || err.Field != "Names[2]" {
+		t.Errorf("Ascending(strings) = %v", err)
+	}
+}
+
+func TestNoOverlappingRanges(t *testing.T) {
+	tests := []struct {
+		name        string
+		ranges      []Range[int]
+		wantField   string
+		wantEarlier int
+	}{
+		{"empty", nil, "", 0},
+		{"adjacent", []Range[int]{{9, 10}, {10, 11}, {11, 12}}, "", 0},
+		{"unsorted", []Range[int]{{14, 16}, {9, 10}, {10, 12}}, "", 0},
+		{"overlap", []Range[int]{{9, 11}, {10, 12}}, "Slots[1]", 0},
+		{"unsorted overlap", []Range[int]{{13, 15}, {9, 10}, {12, 14}}, "Slots[0]", 2},
+		{"contained", []Range[int]{{8, 18}, {9, 10}, {12, 13}}, "Slots[1]", 0},
+		{"after long range", []Range[int]{{1, 10}, {2, 3}, {5, 6}}, "Slots[1]", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NoOverlappingRanges("Slots", tt.ranges)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("NoOverlappingRanges() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Field != tt.wantField || err.MessageKey != MsgOverlap || err.MessageParams[Token] != tt.wantEarlier {
+				t.Errorf("NoOverlappingRanges() = %+v, want an overlap on %s with %d", err, tt.wantField, tt.wantEarlier)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package rapidval
+
+import (
+	"sync"
+	"time"
+)
+
+// WithStats enables recording of per-rule execution counts and latency, available through Validator.Stats.
+//
+// The Validations method of each validated type is recorded as a rule of its own, since the built-in
+// rules run eagerly inside it. Lazily evaluated rules (RuleFunc) are recorded individually, under the
+// name given with Named or under their function name.
+func WithStats() Option {
+	return func(v *Validator) {
+		v.stats = &stats{rules: map[string]*RuleStats{}}
+	}
+}
+
+// RuleStats holds the execution statistics of a single rule.
+type RuleStats struct {
+	Calls    uint64
+	Failures uint64
+	Total    time.Duration
+	Max      time.Duration
+}
+
+// Mean returns the average execution time of the rule.
+func (s RuleStats) Mean() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Calls)
+}
+
+// Stats returns a snapshot of the statistics recorded so far, keyed by rule name.
+// It returns nil if the Validator was not created with WithStats.
+func (v *Validator) Stats() map[string]RuleStats {
+	if v.stats == nil {
+		return nil
+	}
+	return v.stats.snapshot()
+}
+
+// ResetStats discards the statistics recorded so far.
+func (v *Validator) ResetStats() {
+	if v.stats == nil {
+		return
+	}
+	v.stats.mu.Lock()
+	v.stats.rules = map[string]*RuleStats{}
+	v.stats.mu.Unlock()
+}
+
+type stats struct {
+	mu    sync.Mutex
+	rules map[string]*RuleStats
+}
+
+func (s *stats) record(name string, elapsed time.Duration, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rs, ok := s.rules[name]
+	if !ok {
+		rs = &RuleStats{}
+		s.rules[name] = rs
+	}
+	rs.Calls++
+	if failed {
+		rs.Failures++
+	}
+	rs.Total += elapsed
+	if elapsed > rs.Max {
+		rs.Max = elapsed
+	}
+}
+
+func (s *stats) snapshot() map[string]RuleStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]RuleStats, len(s.rules))
+	for name, rs := range s.rules {
+		out[name] = *rs
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package rapidval
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type statsStruct struct {
+	Username string
+}
+
+func (s *statsStruct) Validations() P {
+	return P{
+		Required("Username", s.Username),
+		Named("unique_username", RuleFunc(func(ctx context.Context) *ValidationError {
+			time.Sleep(time.Millisecond)
+			if s.Username == "taken" {
+				return &ValidationError{Field: "Username", MessageKey: "validation.unique"}
+			}
+			return nil
+		})),
+	}
+}
+
+func TestStats(t *testing.T) {
+	if New().Stats() != nil {
+		t.Error("Stats() should be nil when stats are disabled")
+	}
+
+	v := New(WithStats())
+	v.Validate(&statsStruct{Username: "free"})
+	v.Validate(&statsStruct{Username: "taken"})
+	v.Validate(&statsStruct{})
+
+	stats := v.Stats()
+
+	unique := stats["unique_username"]
+	if unique.Calls != 3 || unique.Failures != 1 {
+		t.Errorf("unique_username = %+v, want 3 calls and 1 failure", unique)
+	}
+	if unique.Mean() < time.Millisecond || unique.Max < unique.Mean() {
+		t.Errorf("unique_username latency = mean %v, max %v", unique.Mean(), unique.Max)
+	}
+
+	validations := stats["rapidval.statsStruct.Validations"]
+	if validations.Calls != 3 || validations.Failures != 2 {
+		t.Errorf("statsStruct.Validations = %+v, want 3 calls and 2 failures", validations)
+	}
+
+	v.ResetStats()
+	if len(v.Stats()) != 0 {
+		t.Errorf("Stats() after ResetStats() = %v, want empty", v.Stats())
+	}
+}
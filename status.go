@@ -0,0 +1,72 @@
+package rapidval
+
+import "errors"
+
+// StatusMap maps message keys to the HTTP status codes HTTP helpers respond with.
+// Keys that are not in the map, including all keys of a nil StatusMap, map to 422 Unprocessable Entity.
+// Keys prefixed with WithKeyPrefix must be added under their prefixed names.
+//
+// Statuses are plain numbers here so the package does not depend on net/http.
+type StatusMap map[string]int
+
+// DefaultStatusMap returns the statuses of the built-in message keys that are not plain input errors:
+// MsgForbidden maps to 403, MsgNotFound to 404, MsgInternal to 500, and MsgUnavailable and MsgTimeout
+// to 503. The result is a new map that can be extended with the keys of custom rules:
+//
+//	statuses := rapidval.DefaultStatusMap()
+//	statuses["validation.not_owner"] = http.StatusForbidden
+func DefaultStatusMap() StatusMap {
+	return StatusMap{
+		MsgForbidden:   403,
+		MsgNotFound:    404,
+		MsgInternal:    500,
+		MsgUnavailable: 503,
+		MsgTimeout:     503,
+	}
+}
+
+// Status returns the HTTP status for err. When the errors of ValidationErrors map to different
+// statuses the most significant one wins: server errors, then 401, 403 and 404, then the status of
+// the first error. Errors that are not validation errors map to 500 Internal Server Error.
+func (m StatusMap) Status(err error) int {
+	var verrs ValidationErrors
+	var verr *ValidationError
+	switch {
+	case errors.As(err, &verrs) && len(verrs) > 0:
+	case errors.As(err, &verr):
+		verrs = ValidationErrors{verr}
+	default:
+		return 500
+	}
+
+	status := m.status(verrs[0].MessageKey)
+	for _, ve := range verrs[1:] {
+		if s := m.status(ve.MessageKey); statusRank(s) > statusRank(status) {
+			status = s
+		}
+	}
+	return status
+}
+
+func (m StatusMap) status(key string) int {
+	if s, ok := m[key]; ok {
+		return s
+	}
+	return 422
+}
+
+// statusRank orders statuses by how much they take precedence over input errors:
+// a request that is forbidden should not reveal which of its fields are invalid.
+func statusRank(status int) int {
+	switch {
+	case status >= 500:
+		return 4
+	case status == 401:
+		return 3
+	case status == 403:
+		return 2
+	case status == 404:
+		return 1
+	}
+	return 0
+}
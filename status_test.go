@@ -0,0 +1,44 @@
+package rapidval
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStatusMap(t *testing.T) {
+	statuses := DefaultStatusMap()
+	statuses["validation.login_required"] = http.StatusUnauthorized
+
+	required := newError("Name", MsgRequired, "")
+	forbidden := newError("ProjectID", MsgForbidden, "p1")
+	notFound := newError("TeamID", MsgNotFound, "t1")
+	login := newError("", "validation.login_required", nil)
+	timeout := newError("", MsgTimeout, nil)
+
+	tests := []struct {
+		name string
+		m    StatusMap
+		err  error
+		want int
+	}{
+		{"input error", statuses, ValidationErrors{required}, http.StatusUnprocessableEntity},
+		{"single error", statuses, forbidden, http.StatusForbidden},
+		{"wrapped", statuses, fmt.Errorf("create: %w", ValidationErrors{notFound}), http.StatusNotFound},
+		{"forbidden over input", statuses, ValidationErrors{required, forbidden}, http.StatusForbidden},
+		{"forbidden over not found", statuses, ValidationErrors{notFound, forbidden}, http.StatusForbidden},
+		{"custom key", statuses, ValidationErrors{forbidden, login}, http.StatusUnauthorized},
+		{"server error wins", statuses, ValidationErrors{login, timeout}, http.StatusServiceUnavailable},
+		{"nil map", nil, ValidationErrors{forbidden}, http.StatusUnprocessableEntity},
+		{"empty errors", statuses, ValidationErrors{}, http.StatusInternalServerError},
+		{"other error", statuses, errors.New("boom"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Status(tt.err); got != tt.want {
+				t.Errorf("Status() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package rapidval
+
+import (
+	"net/netip"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// s3ReservedPrefixes and s3ReservedSuffixes are the bucket name affixes reserved by Amazon S3.
+var (
+	s3ReservedPrefixes = []string{"xn--", "sthree-", "amzn-s3-demo-"}
+	s3ReservedSuffixes = []string{"-s3alias", "--ol-s3", ".mrap", "--x-s3", "--table-s3"}
+)
+
+// S3BucketName validates a general purpose Amazon S3 bucket name: 3 to 63 lowercase letters, digits,
+// dots and hyphens, starting and ending with a letter or digit, without adjacent dots, not formatted
+// as an IP address and without the prefixes and suffixes reserved by AWS.
+func S3BucketName(field string, value string) *ValidationError {
+	if !s3BucketName(value) {
+		return newError(field, MsgInvalidBucketName, value)
+	}
+	return nil
+}
+
+func s3BucketName(s string) bool {
+	if len(s) < 3 || len(s) > 63 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'a' && c <= 'z' || c >= '0' && c <= '9':
+		case c == '-' || c == '.':
+			if i == 0 || i == len(s)-1 || c == '.' && s[i-1] == '.' {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	if _, err := netip.ParseAddr(s); err == nil {
+		return false
+	}
+	for _, p := range s3ReservedPrefixes {
+		if strings.HasPrefix(s, p) {
+			return false
+		}
+	}
+	for _, suffix := range s3ReservedSuffixes {
+		if strings.HasSuffix(s, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// ObjectKey validates an object key (name) accepted by both Amazon S3 and Google Cloud Storage:
+// 1 to 1024 bytes of valid UTF-8 without control characters such as line breaks,
+// and neither "." nor "..".
+func ObjectKey(field string, value string) *ValidationError {
+	if !objectKey(value) {
+		return newError(field, MsgInvalidObjectKey, value)
+	}
+	return nil
+}
+
+func objectKey(s string) bool {
+	if s == "" || len(s) > 1024 || s == "." || s == ".." || !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package rapidval
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestS3BucketName(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"my-bucket", false},
+		{"logs.example.com", false},
+		{"abc", false},
+		{"ab", true},
+		{strings.Repeat("a", 64), true},
+		{"My-Bucket", true},
+		{"my_bucket", true},
+		{".bucket", true},
+		{"bucket-", true},
+		{"my..bucket", true},
+		{"192.168.1.1", true},
+		{"xn--bucket", true},
+		{"sthree-bucket", true},
+		{"bucket-s3alias", true},
+		{"bucket--ol-s3", true},
+	}
+
+	for _, tt := range tests {
+		err := S3BucketName("Bucket", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("S3BucketName(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgInvalidBucketName {
+			t.Errorf("S3BucketName(%q) message key = %v", tt.value, err.MessageKey)
+		}
+	}
+}
+
+func TestObjectKey(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"photos/2024/cat.jpg", false},
+		{"raporlar/özet 1.pdf", false},
+		{".hidden", false},
+		{strings.Repeat("a", 1024), false},
+		{strings.Repeat("a", 1025), true},
+		{"", true},
+		{".", true},
+		{"..", true},
+		{"line\nbreak", true},
+		{"tab\there", true},
+		{"bad\xffutf8", true},
+	}
+
+	for _, tt := range tests {
+		err := ObjectKey("Key", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ObjectKey(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgInvalidObjectKey {
+			t.Errorf("ObjectKey(%q) message key = %v", tt.value, err.MessageKey)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+//go:build !rapidval_noreflect
+
+package rapidval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TagName is the struct tag read by ValidateStruct.
+const TagName = "validate"
+
+// ErrNotStruct is returned by ValidateStruct when it is called with a value that is not a struct or a pointer to a struct.
+var ErrNotStruct = errors.New("rapidval: ValidateStruct requires a struct or a pointer to a struct")
+
+// ErrInvalidTag is returned by ValidateStruct when a validate tag of the struct has an unknown rule, an invalid
+// parameter, or a rule that does not apply to the kind of its field. The returned error wraps it and names the field.
+var ErrInvalidTag = errors.New("rapidval: invalid validate tag")
+
+// ValidateStruct validates a struct using `validate:"..."` tags.
+// It understands the most common go-playground/validator tags so existing DTOs can be migrated gradually:
+//
+//	required, omitempty, min=N, max=N, gte=N, lte=N, email, oneof=a b c
+//
+// For strings min/max apply to the number of characters (runes), for slices and maps to the length,
+// and for numbers to the value. On pointer fields, required checks the pointer and the other rules the
+// value it points to; they are skipped for nil pointers.
+// Nested struct fields are validated recursively and reported as "Parent.Child", and structs in slices and
+// arrays as "Items[2].Name", like with Each; nil elements are skipped. Interface fields are
+// validated by their concrete value, as with Dispatch, or by its tags if it is a struct. Fields of embedded
+// structs, including unexported ones, are validated the same way and reported under the embedded
+// type's name, e.g. "Address.City", or as promoted fields, e.g. "City", with FlattenEmbedded.
+// Unknown tags, invalid parameters and rules on fields of unsupported kinds are reported as ErrInvalidTag
+// instead of being silently ignored. Tags are parsed once per type, when it is first validated, and their
+// parameters are parsed then too, so validation itself does no parsing.
+func ValidateStruct(s interface{}, opts ...StructOption) error {
+	rv := reflect.ValueOf(s)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return ErrNotStruct
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+
+	var cfg structConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	errs, err := validateStructValue(rv, fieldPrefix{}, &cfg, nil)
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// StructOption configures ValidateStruct.
+type StructOption func(*structConfig)
+
+type structConfig struct {
+	flattenEmbedded bool
+}
+
+// FlattenEmbedded reports the fields of embedded structs as promoted fields, e.g. "City"
+// instead of "Address.City", matching how they are accessed in Go and encoded by encoding/json.
+func FlattenEmbedded() StructOption {
+	return func(c *structConfig) {
+		c.flattenEmbedded = true
+	}
+}
+
+// tagRule is a single parsed rule of a validate tag, e.g. "min=3".
+// Its parameter is folded once when the tags of a type are parsed, for the kind of the field.
+type tagRule struct {
+	name  string
+	param string
+	// n, u and f hold the folded min/max parameter: n for lengths and signed integers,
+	// u for unsigned integers and f for floats.
+	n int64
+	u uint64
+	f float64
+	// allowed and set hold the values of a oneof rule.
+	allowed []string
+	set     map[string]struct{}
+}
+
+// tagField is a struct field with its parsed validate tag.
+type tagField struct {
+	index     int
+	name      string
+	rules     []tagRule
+	omitempty bool
+	// embedded is set for anonymous struct fields, whose fields are promoted.
+	embedded bool
+}
+
+// tagFields are the parsed tags of a struct type, or the error that made them invalid.
+type tagFields struct {
+	fields []tagField
+	err    error
+}
+
+var tagCache sync.Map // map[reflect.Type]tagFields
+
+func cachedTagFields(t reflect.Type) ([]tagField, error) {
+	if tf, ok := tagCache.Load(t); ok {
+		return tf.(tagFields).fields, tf.(tagFields).err
+	}
+	fields, err := parseTagFields(t)
+	tagCache.Store(t, tagFields{fields: fields, err: err})
+	return fields, err
+}
+
+func parseTagFields(t reflect.Type) ([]tagField, error) {
+	var fields []tagField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		embedded := sf.Anonymous && isStructType(sf.Type)
+		if !sf.IsExported() && !embedded {
+			continue
+		}
+		tag := sf.Tag.Get(TagName)
+		if tag == "-" {
+			continue
+		}
+		field := tagField{index: i, name: sf.Name, embedded: embedded}
+		// Unexported embedded structs are only walked for their promoted fields, since their
+		// own value cannot be read through reflection.
+		if tag != "" && sf.IsExported() {
+			for _, part := range strings.Split(tag, ",") {
+				name, param, _ := strings.Cut(strings.TrimSpace(part), "=")
+				switch name {
+				case "":
+					continue
+				case "omitempty":
+					field.omitempty = true
+					continue
+				case "required", "email", "min", "max", "gte", "lte", "oneof":
+				default:
+					return nil, fmt.Errorf("%w: unknown rule %q on field %s.%s", ErrInvalidTag, name, t.Name(), sf.Name)
+				}
+				r, err := foldTagRule(t.Name()+"."+sf.Name, elemKind(sf.Type), tagRule{name: name, param: param})
+				if err != nil {
+					return nil, err
+				}
+				field.rules = append(field.rules, r)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// elemKind returns the kind of t, or of the type it points to if t is a pointer type.
+func elemKind(t reflect.Type) reflect.Kind {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind()
+}
+
+// indirect dereferences fv if it is a pointer. It returns false if fv is a nil pointer.
+func indirect(fv reflect.Value) (reflect.Value, bool) {
+	for fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return fv, false
+		}
+		fv = fv.Elem()
+	}
+	return fv, true
+}
+
+func isStructType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+func validateStructValue(rv reflect.Value, prefix fieldPrefix, cfg *structConfig, errs ValidationErrors) (ValidationErrors, error) {
+	fields, err := cachedTagFields(rv.Type())
+	if err != nil {
+		return errs, err
+	}
+	for _, f := range fields {
+		fv := rv.Field(f.index)
+		name := prefix.field + f.name
+
+		if f.omitempty && fv.IsZero() {
+			continue
+		}
+		for _, r := range f.rules {
+			// Like validator/v10, required checks pointers themselves and the other rules the
+			// values they point to, skipping nil pointers as if the field were omitempty.
+			rv := fv
+			if r.name != "required" {
+				var ok bool
+				if rv, ok = indirect(fv); !ok {
+					continue
+				}
+			}
+			if err := applyTagRule(name, rv, r); err != nil {
+				if prefix.field != "" {
+					err.path, err.pathField = prefix.at(f.name), name
+				}
+				errs = append(errs, err)
+			}
+		}
+
+		if fv.Kind() == reflect.Interface && !fv.IsNil() {
+			var dispatched bool
+			if errs, dispatched = (&Validator{}).dispatch(context.Background(), prefix.nest(f.name, -1), fv.Interface(), errs); dispatched {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if nested, ok := nestedStruct(fv); ok {
+			nestedPrefix := prefix.nest(f.name, -1)
+			if f.embedded && cfg.flattenEmbedded {
+				nestedPrefix = prefix
+			}
+			if errs, err = validateStructValue(nested, nestedPrefix, cfg, errs); err != nil {
+				return errs, err
+			}
+			continue
+		}
+		if (fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array) && isStructType(fv.Type().Elem()) {
+			for i := 0; i < fv.Len(); i++ {
+				if nested, ok := nestedStruct(fv.Index(i)); ok {
+					if errs, err = validateStructValue(nested, prefix.nest(f.name, i), cfg, errs); err != nil {
+						return errs, err
+					}
+				}
+			}
+		}
+	}
+	return errs, nil
+}
+
+func nestedStruct(fv reflect.Value) (reflect.Value, bool) {
+	fv, ok := indirect(fv)
+	if !ok || fv.Kind() != reflect.Struct || fv.Type() == reflect.TypeOf(time.Time{}) {
+		return fv, false
+	}
+	return fv, true
+}
+
+// foldTagRule parses the parameter of r for a field of kind, so that invalid parameters are
+// reported when the tags are parsed and validation does no parsing.
+func foldTagRule(field string, kind reflect.Kind, r tagRule) (tagRule, error) {
+	var err error
+	switch r.name {
+	case "email":
+		if kind != reflect.String {
+			return r, fmt.Errorf("%w: email rule on field %s of kind %s", ErrInvalidTag, field, kind)
+		}
+	case "min", "gte", "max", "lte":
+		switch kind {
+		case reflect.String, reflect.Slice, reflect.Map, reflect.Array,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			r.n, err = strconv.ParseInt(r.param, 10, 64)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			r.u, err = strconv.ParseUint(r.param, 10, 64)
+		case reflect.Float32, reflect.Float64:
+			r.f, err = strconv.ParseFloat(r.param, 64)
+		default:
+			return r, fmt.Errorf("%w: %s rule on field %s of kind %s", ErrInvalidTag, r.name, field, kind)
+		}
+		if err != nil {
+			return r, fmt.Errorf("%w: invalid %s parameter %q on field %s", ErrInvalidTag, r.name, r.param, field)
+		}
+	case "oneof":
+		r.allowed = strings.Fields(r.param)
+		r.set = make(map[string]struct{}, len(r.allowed))
+		for _, a := range r.allowed {
+			r.set[a] = struct{}{}
+		}
+	}
+	return r, nil
+}
+
+func applyTagRule(field string, fv reflect.Value, r tagRule) *ValidationError {
+	switch r.name {
+	case "required":
+		if fv.IsZero() {
+			return newError(field, MsgRequired, fv.Interface())
+		}
+		return nil
+	case "email":
+		return Email(field, fv.String())
+	case "min", "gte":
+		return tagBound(field, fv, r, true)
+	case "max", "lte":
+		return tagBound(field, fv, r, false)
+	case "oneof":
+		return tagOneOf(field, fv, r)
+	}
+	return nil
+}
+
+func tagBound(field string, fv reflect.Value, r tagRule, lower bool) *ValidationError {
+	switch fv.Kind() {
+	case reflect.String:
+		// Like validator/v10, lengths of strings count characters, not bytes.
+		if lower {
+			return MinRunes(field, fv.String(), int(r.n))
+		}
+		return MaxRunes(field, fv.String(), int(r.n))
+	case reflect.Slice, reflect.Map, reflect.Array:
+		n := int64(fv.Len())
+		if lower && n < r.n {
+			return boundError(field, MsgMinLength, Min, int(r.n), fv.Interface())
+		}
+		if !lower && n > r.n {
+			return boundError(field, MsgMaxLength, Max, int(r.n), fv.Interface())
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if lower && fv.Int() < r.n {
+			return boundError(field, MsgMin, Min, r.n, fv.Interface())
+		}
+		if !lower && fv.Int() > r.n {
+			return boundError(field, MsgMax, Max, r.n, fv.Interface())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if lower && fv.Uint() < r.u {
+			return boundError(field, MsgMin, Min, r.u, fv.Interface())
+		}
+		if !lower && fv.Uint() > r.u {
+			return boundError(field, MsgMax, Max, r.u, fv.Interface())
+		}
+	case reflect.Float32, reflect.Float64:
+		if lower && fv.Float() < r.f {
+			return boundError(field, MsgMin, Min, r.f, fv.Interface())
+		}
+		if !lower && fv.Float() > r.f {
+			return boundError(field, MsgMax, Max, r.f, fv.Interface())
+		}
+	}
+	return nil
+}
+
+func boundError(field, key, param string, bound, value interface{}) *ValidationError {
+	err := newError(field, key, value)
+	err.MessageParams[param] = bound
+	return err
+}
+
+func tagOneOf(field string, fv reflect.Value, r tagRule) *ValidationError {
+	var value string
+	if fv.Kind() == reflect.String {
+		value = fv.String()
+	} else {
+		value = fmt.Sprint(fv.Interface())
+	}
+	if _, ok := r.set[value]; ok {
+		return nil
+	}
+	err := newError(field, MsgOneOf, fv.Interface())
+	err.MessageParams[Allowed] = r.allowed
+	return err
+}
@@ -0,0 +1,391 @@
+//go:build !rapidval_noreflect
+
+package rapidval
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type tagAddress struct {
+	City string `validate:"required"`
+}
+
+type tagUser struct {
+	Name     string            `validate:"required,min=2,max=10"`
+	Email    string            `validate:"required,email"`
+	Age      int               `validate:"gte=18,lte=100"`
+	Score    float64           `validate:"min=0.5"`
+	Role     string            `validate:"oneof=admin user"`
+	Nickname string            `validate:"omitempty,min=3"`
+	Tags     []string          `validate:"max=2"`
+	Address  *tagAddress       `validate:"required"`
+	Ignored  string            `validate:"-"`
+	Meta     map[string]string ``
+}
+
+func TestValidateStruct(t *testing.T) {
+	valid := tagUser{
+		Name:    "John",
+		Email:   "john@example.com",
+		Age:     30,
+		Score:   1,
+		Role:    "admin",
+		Address: &tagAddress{City: "Istanbul"},
+	}
+
+	t.Run("valid struct", func(t *testing.T) {
+		if err := ValidateStruct(&valid); err != nil {
+			t.Errorf("ValidateStruct() returned error: %v", err)
+		}
+	})
+
+	t.Run("invalid struct", func(t *testing.T) {
+		u := tagUser{
+			Name:     "J",
+			Email:    "invalid",
+			Age:      15,
+			Score:    0.1,
+			Role:     "guest",
+			Nickname: "ab",
+			Tags:     []string{"a", "b", "c"},
+			Address:  &tagAddress{},
+		}
+		err := ValidateStruct(u)
+		verr, ok := err.(ValidationErrors)
+		if !ok {
+			t.Fatalf("ValidateStruct() should return ValidationErrors, got %T", err)
+		}
+
+		want := map[string]string{
+			"Name":         MsgMinLength,
+			"Email":        MsgInvalidEmail,
+			"Age":          MsgMin,
+			"Score":        MsgMin,
+			"Role":         MsgOneOf,
+			"Nickname":     MsgMinLength,
+			"Tags":         MsgMaxLength,
+			"Address.City": MsgRequired,
+		}
+		if len(verr) != len(want) {
+			t.Errorf("got %d errors, want %d: %v", len(verr), len(want), verr)
+		}
+		for _, e := range verr {
+			if want[e.Field] != e.MessageKey {
+				t.Errorf("field %s: message key = %v, want %v", e.Field, e.MessageKey, want[e.Field])
+			}
+		}
+	})
+
+	t.Run("nil nested pointer", func(t *testing.T) {
+		u := valid
+		u.Address = nil
+		verr, _ := ValidateStruct(&u).(ValidationErrors)
+		if len(verr) != 1 || verr[0].Field != "Address" || verr[0].MessageKey != MsgRequired {
+			t.Errorf("unexpected errors: %v", verr)
+		}
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		if err := ValidateStruct("text"); !errors.Is(err, ErrNotStruct) {
+			t.Errorf("ValidateStruct() error = %v, want ErrNotStruct", err)
+		}
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		err := ValidateStruct(struct {
+			Name string `validate:"unknown"`
+		}{})
+		if !errors.Is(err, ErrInvalidTag) {
+			t.Errorf("ValidateStruct() error = %v, want ErrInvalidTag", err)
+		}
+	})
+
+	t.Run("unknown tag in nested struct", func(t *testing.T) {
+		type inner struct {
+			Code string `validate:"uuid"`
+		}
+		err := ValidateStruct(struct {
+			Inner inner
+		}{})
+		if !errors.Is(err, ErrInvalidTag) {
+			t.Errorf("ValidateStruct() error = %v, want ErrInvalidTag", err)
+		}
+	})
+}
+
+func TestValidateStructFoldedParams(t *testing.T) {
+	type level struct {
+		Level uint8    `validate:"oneof=1 2 3"`
+		Ratio float64  `validate:"gte=0.5,lte=1"`
+		Tags  []string `validate:"max=2"`
+	}
+	err := ValidateStruct(level{Level: 4, Ratio: 0.25, Tags: []string{"a", "b", "c"}})
+	verr, _ := err.(ValidationErrors)
+	want := []struct {
+		field, key, param string
+		bound             interface{}
+	}{
+		{"Level", MsgOneOf, Allowed, []string{"1", "2", "3"}},
+		{"Ratio", MsgMin, Min, 0.5},
+		{"Tags", MsgMaxLength, Max, 2},
+	}
+	if len(verr) != len(want) {
+		t.Fatalf("ValidateStruct() = %v, want %d errors", err, len(want))
+	}
+	for i, w := range want {
+		if verr[i].Field != w.field || verr[i].MessageKey != w.key || !reflect.DeepEqual(verr[i].MessageParams[w.param], w.bound) {
+			t.Errorf("errs[%d] = %s %s %v, want %s %s %v", i, verr[i].Field, verr[i].MessageKey, verr[i].MessageParams[w.param], w.field, w.key, w.bound)
+		}
+	}
+	if err := ValidateStruct(level{Level: 2, Ratio: 1}); err != nil {
+		t.Errorf("ValidateStruct() = %v, want nil", err)
+	}
+
+	// Parameters are checked when the tags are parsed, even if the field would be skipped.
+	for name, s := range map[string]interface{}{
+		"invalid parameter": struct {
+			Name string `validate:"omitempty,min=x"`
+		}{},
+		"unsupported kind": struct {
+			Done chan int `validate:"omitempty,min=3"`
+		}{},
+		"email on non-string": struct {
+			Age int `validate:"omitempty,email"`
+		}{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := ValidateStruct(s); !errors.Is(err, ErrInvalidTag) {
+				t.Errorf("ValidateStruct() error = %v, want ErrInvalidTag", err)
+			}
+		})
+	}
+}
+
+func TestValidateStructPointers(t *testing.T) {
+	type profile struct {
+		Nickname *string `validate:"omitempty,min=3"`
+		Email    *string `validate:"email"`
+		Age      *int    `validate:"required,gte=18"`
+	}
+	str := func(s string) *string { return &s }
+	num := func(n int) *int { return &n }
+
+	tests := []struct {
+		name string
+		p    profile
+		want []string
+	}{
+		{"nil pointers", profile{}, []string{"Age " + MsgRequired}},
+		{"valid values", profile{Nickname: str("Işık"), Email: str("a@example.com"), Age: num(18)}, nil},
+		{"invalid values", profile{Nickname: str("Ay"), Email: str("a"), Age: num(17)}, []string{
+			"Nickname " + MsgMinLength, "Email " + MsgInvalidEmail, "Age " + MsgMin,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			verrs, _ := ValidateStruct(tt.p).(ValidationErrors)
+			for _, e := range verrs {
+				got = append(got, e.Field+" "+e.MessageKey)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateStruct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateStructRuneLength(t *testing.T) {
+	type name struct {
+		First string `validate:"min=3,max=4"`
+	}
+	tests := []struct {
+		value   string
+		wantKey string
+	}{
+		{"Işık", ""},
+		{"Çağ", ""},
+		{"Ağ", MsgMinLength},
+		{"Çağrı", MsgMaxLength},
+	}
+	for _, tt := range tests {
+		verr, _ := ValidateStruct(name{First: tt.value}).(ValidationErrors)
+		var key string
+		if len(verr) > 0 {
+			key = verr[0].MessageKey
+		}
+		if len(verr) > 1 || key != tt.wantKey {
+			t.Errorf("ValidateStruct(%q) = %v, want %q", tt.value, verr, tt.wantKey)
+		}
+	}
+}
+
+type tagGeo struct {
+	Country string `validate:"required,oneof=TR DE"`
+}
+
+type tagLocation struct {
+	tagGeo
+	City string `validate:"required"`
+}
+
+type tagAudit struct {
+	CreatedBy string `validate:"required"`
+}
+
+type tagShop struct {
+	*tagAudit
+	tagLocation
+	Name string `validate:"required"`
+}
+
+func TestValidateStructEmbedded(t *testing.T) {
+	shop := tagShop{
+		tagAudit:    &tagAudit{},
+		tagLocation: tagLocation{tagGeo: tagGeo{Country: "FR"}},
+	}
+
+	tests := []struct {
+		name string
+		opts []StructOption
+		want map[string]string
+	}{
+		{
+			name: "nested paths",
+			want: map[string]string{
+				"tagAudit.CreatedBy":         MsgRequired,
+				"tagLocation.tagGeo.Country": MsgOneOf,
+				"tagLocation.City":           MsgRequired,
+				"Name":                       MsgRequired,
+			},
+		},
+		{
+			name: "flattened",
+			opts: []StructOption{FlattenEmbedded()},
+			want: map[string]string{
+				"CreatedBy": MsgRequired,
+				"Country":   MsgOneOf,
+				"City":      MsgRequired,
+				"Name":      MsgRequired,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verr, ok := ValidateStruct(&shop, tt.opts...).(ValidationErrors)
+			if !ok || len(verr) != len(tt.want) {
+				t.Fatalf("ValidateStruct() = %v, want %d errors", verr, len(tt.want))
+			}
+			for _, e := range verr {
+				if tt.want[e.Field] != e.MessageKey {
+					t.Errorf("field %s: message key = %v, want %v", e.Field, e.MessageKey, tt.want[e.Field])
+				}
+			}
+		})
+	}
+
+	t.Run("nil embedded pointer", func(t *testing.T) {
+		valid := tagShop{
+			tagLocation: tagLocation{tagGeo: tagGeo{Country: "TR"}, City: "Istanbul"},
+			Name:        "Corner Shop",
+		}
+		if err := ValidateStruct(valid); err != nil {
+			t.Errorf("ValidateStruct() returned error: %v", err)
+		}
+	})
+}
+
+func BenchmarkValidateStruct(b *testing.B) {
+	u := &tagUser{
+		Name:    "John",
+		Email:   "john@example.com",
+		Age:     30,
+		Score:   1,
+		Role:    "admin",
+		Address: &tagAddress{City: "Istanbul"},
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ValidateStruct(u)
+	}
+}
+
+type tagOrder struct {
+	Payment paymentMethod `validate:"required"`
+	Note    interface{}
+}
+
+type tagNote struct {
+	Text string `validate:"required"`
+}
+
+func TestValidateStructInterface(t *testing.T) {
+	err := ValidateStruct(tagOrder{Payment: &cardPayment{Number: "123"}, Note: &tagNote{}})
+	verr, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("ValidateStruct() should return ValidationErrors, got %T", err)
+	}
+	want := map[string]string{
+		"Payment.Number": MsgMinLength,
+		"Note.Text":      MsgRequired,
+	}
+	if len(verr) != len(want) {
+		t.Errorf("got %d errors, want %d: %v", len(verr), len(want), verr)
+	}
+	for _, e := range verr {
+		if want[e.Field] != e.MessageKey {
+			t.Errorf("field %s: message key = %v, want %v", e.Field, e.MessageKey, want[e.Field])
+		}
+	}
+
+	verr, _ = ValidateStruct(tagOrder{}).(ValidationErrors)
+	if len(verr) != 1 || verr[0].Field != "Payment" || verr[0].MessageKey != MsgRequired {
+		t.Errorf("nil interface: unexpected errors %v", verr)
+	}
+}
+
+type tagLine struct {
+	SKU      string `validate:"required"`
+	Quantity int    `validate:"min=1"`
+}
+
+type tagInvoice struct {
+	Lines    []tagLine `validate:"min=1"`
+	Optional []*tagLine
+	Fixed    [2]tagLine
+}
+
+func TestValidateStructSlices(t *testing.T) {
+	valid := tagLine{SKU: "A1", Quantity: 1}
+	inv := tagInvoice{
+		Lines:    []tagLine{valid, {SKU: "B2"}, {Quantity: 3}},
+		Optional: []*tagLine{nil, {Quantity: 1}},
+		Fixed:    [2]tagLine{valid, valid},
+	}
+
+	verr, ok := ValidateStruct(inv).(ValidationErrors)
+	if !ok {
+		t.Fatalf("ValidateStruct() should return ValidationErrors")
+	}
+	want := map[string]string{
+		"Lines[1].Quantity": MsgMin,
+		"Lines[2].SKU":      MsgRequired,
+		"Optional[1].SKU":   MsgRequired,
+	}
+	if len(verr) != len(want) {
+		t.Errorf("got %d errors, want %d: %v", len(verr), len(want), verr)
+	}
+	for _, e := range verr {
+		if want[e.Field] != e.MessageKey {
+			t.Errorf("field %s: message key = %v, want %v", e.Field, e.MessageKey, want[e.Field])
+		}
+	}
+
+	verr, _ = ValidateStruct(tagInvoice{Fixed: [2]tagLine{valid, valid}}).(ValidationErrors)
+	if len(verr) != 1 || verr[0].Field != "Lines" || verr[0].MessageKey != MsgMinLength {
+		t.Errorf("empty slice: unexpected errors %v", verr)
+	}
+}
@@ -0,0 +1,48 @@
+package rapidval
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NoLeadingTrailingSpace validates that a string does not start or end with whitespace.
+func NoLeadingTrailingSpace(field string, value string) *ValidationError {
+	if value == "" {
+		return nil
+	}
+	first, _ := utf8.DecodeRuneInString(value)
+	last, _ := utf8.DecodeLastRuneInString(value)
+	if unicode.IsSpace(first) || unicode.IsSpace(last) {
+		return newError(field, MsgLeadingTrailingSpace, value)
+	}
+	return nil
+}
+
+// SingleLine validates that a string contains no line breaks (\n or \r).
+func SingleLine(field string, value string) *ValidationError {
+	if strings.ContainsAny(value, "\n\r") {
+		return newError(field, MsgSingleLine, value)
+	}
+	return nil
+}
+
+// MaxConsecutiveRepeats validates that no character is repeated more than max times in a row,
+// e.g. "Heyyyy" fails for max 3.
+func MaxConsecutiveRepeats(field string, value string, max int) *ValidationError {
+	var prev rune
+	run := 0
+	for i, r := range value {
+		if i > 0 && r == prev {
+			run++
+		} else {
+			prev, run = r, 1
+		}
+		if run > max {
+			err := newError(field, MsgMaxRepeats, value)
+			err.MessageParams[Max] = max
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package rapidval
+
+import "testing"
+
+func TestNoLeadingTrailingSpace(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"", false},
+		{"John Doe", false},
+		{"\u00a0John", true},
+		{"John ", true},
+		{"John\t", true},
+		{" John", true},
+	}
+
+	for _, tt := range tests {
+		err := NoLeadingTrailingSpace("Name", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("NoLeadingTrailingSpace(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgLeadingTrailingSpace {
+			t.Errorf("NoLeadingTrailingSpace(%q) message key = %v", tt.value, err.MessageKey)
+		}
+	}
+}
+
+func TestSingleLine(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"", false},
+		{"A title", false},
+		{"A\ntitle", true},
+		{"A title\r", true},
+	}
+
+	for _, tt := range tests {
+		err := SingleLine("Title", tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SingleLine(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if err != nil && err.MessageKey != MsgSingleLine {
+			t.Errorf("SingleLine(%q) message key = %v", tt.value, err.MessageKey)
+		}
+	}
+}
+
+func TestMaxConsecutiveRepeats(t *testing.T) {
+	tests := []struct {
+		value   string
+		max     int
+		wantErr bool
+	}{
+		{"", 3, false},
+		{"Hello", 2, false},
+		{"Hello", 1, true},
+		{"Heyyy", 3, false},
+		{"Heyyyy", 3, true},
+		{"ğğğ", 2, true},
+		{"!!!!", 3, true},
+	}
+
+	for _, tt := range tests {
+		err := MaxConsecutiveRepeats("Name", tt.value, tt.max)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("MaxConsecutiveRepeats(%q, %d) error = %v, wantErr %v", tt.value, tt.max, err, tt.wantErr)
+		}
+		if err != nil && (err.MessageKey != MsgMaxRepeats || err.MessageParams[Max] != tt.max) {
+			t.Errorf("MaxConsecutiveRepeats(%q, %d) = %v %v", tt.value, tt.max, err.MessageKey, err.MessageParams)
+		}
+	}
+}
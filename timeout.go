@@ -0,0 +1,42 @@
+package rapidval
+
+import (
+	"context"
+	"time"
+)
+
+// WithTimeout limits the time a single validation may spend on lazily evaluated rules.
+// Once the budget, or the deadline of the context passed to ValidateContext, is exceeded, the remaining
+// lazy rules are skipped and a struct-level MsgTimeout error is reported, see WithTimeoutMode.
+// Rules that are already running are expected to return when their context is done.
+func WithTimeout(d time.Duration) Option {
+	return func(v *Validator) {
+		v.timeout = d
+	}
+}
+
+// WithTimeoutMode decides how exceeded deadlines are reported: as a MsgTimeout error (FailAsError,
+// the default), as a MsgTimeout warning (FailAsWarning), or not at all (FailSkip), in which case the
+// skipped rules pass.
+func WithTimeoutMode(mode FailureMode) Option {
+	return func(v *Validator) {
+		v.timeoutMode = mode
+	}
+}
+
+// deadlineExceeded appends the MsgTimeout error for a lazy rule skipped because ctx is done,
+// unless it has already been reported.
+func (v *Validator) deadlineExceeded(ctx context.Context, errs ValidationErrors) ValidationErrors {
+	if v.timeoutMode == FailSkip {
+		return errs
+	}
+	for _, err := range errs {
+		if err.MessageKey == MsgTimeout && err.Field == "" {
+			return errs
+		}
+	}
+	err := newError("", MsgTimeout, nil)
+	err.Cause = ctx.Err()
+	err.Warning = v.timeoutMode == FailAsWarning
+	return appendError(errs, fieldPrefix{}, err)
+}
@@ -0,0 +1,79 @@
+package rapidval
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type slowStruct struct {
+	Name  string
+	calls *int
+}
+
+func (s *slowStruct) Validations() P {
+	slow := RuleFunc(func(ctx context.Context) *ValidationError {
+		*s.calls++
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+		}
+		return nil
+	})
+	return P{
+		slow,
+		slow,
+		Required("Name", s.Name),
+		slow,
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		wantKeys []string
+		warnings int
+	}{
+		{"error", []Option{WithTimeout(10 * time.Millisecond)}, []string{MsgTimeout, MsgRequired}, 0},
+		{"warning", []Option{WithTimeout(10 * time.Millisecond), WithTimeoutMode(FailAsWarning)}, []string{MsgRequired}, 1},
+		{"pass through", []Option{WithTimeout(10 * time.Millisecond), WithTimeoutMode(FailSkip)}, []string{MsgRequired}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			res := New(tt.opts...).Run(&slowStruct{calls: &calls})
+			if calls != 1 {
+				t.Errorf("slow rule called %d times, want 1", calls)
+			}
+			errs := res.Errors()
+			if len(errs) != len(tt.wantKeys) {
+				t.Fatalf("Errors() = %v, want %v", errs, tt.wantKeys)
+			}
+			for i, key := range tt.wantKeys {
+				if errs[i].MessageKey != key {
+					t.Errorf("errs[%d].MessageKey = %v, want %v", i, errs[i].MessageKey, key)
+				}
+			}
+			if len(res.Warnings()) != tt.warnings {
+				t.Errorf("Warnings() = %v, want %d", res.Warnings(), tt.warnings)
+			}
+		})
+	}
+}
+
+func TestContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	calls := 0
+	verr, _ := New().ValidateContext(ctx, &slowStruct{Name: "John", calls: &calls}).(ValidationErrors)
+	if len(verr) != 1 || verr[0].MessageKey != MsgTimeout || verr[0].Field != "" {
+		t.Fatalf("ValidateContext() = %v, want a single struct-level timeout", verr)
+	}
+	if !errors.Is(verr[0], context.DeadlineExceeded) {
+		t.Errorf("cause = %v, want context.DeadlineExceeded", verr[0].Cause)
+	}
+}
@@ -0,0 +1,70 @@
+package rapidval
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// TraceEvent describes the outcome of a single rule evaluated by a traced Validator.
+type TraceEvent struct {
+	// Validations is the Validations method the rule came from, e.g. "examples.User.Validations".
+	Validations string
+	// Index is the position of the rule in P, or -1 if the Validations method itself panicked.
+	Index int
+	// Rule is the name of a lazily evaluated rule. It is empty for eager rules, whose name is not known.
+	Rule string
+	// Err is the error produced by the rule, or nil if it passed.
+	// It is owned by the Validator and must not be retained after the trace function returns.
+	Err *ValidationError
+}
+
+// String formats the event as a single line, e.g. "examples.User.Validations[0] Name: validation.required".
+func (e TraceEvent) String() string {
+	name := e.Rule
+	if e.Err != nil && e.Err.Field != "" {
+		if name != "" {
+			name += " "
+		}
+		name += e.Err.Field
+	}
+	outcome := "ok"
+	if e.Err != nil {
+		outcome = e.Err.MessageKey
+	}
+	if name == "" {
+		return fmt.Sprintf("%s[%d]: %s", e.Validations, e.Index, outcome)
+	}
+	return fmt.Sprintf("%s[%d] %s: %s", e.Validations, e.Index, name, outcome)
+}
+
+// WithTraceFunc calls fn for every rule the Validator evaluates, passing or not.
+// It is meant for debugging complex Validations methods and adds overhead to every call.
+func WithTraceFunc(fn func(TraceEvent)) Option {
+	return func(v *Validator) {
+		v.trace = fn
+	}
+}
+
+// WithTrace writes a line to w for every rule the Validator evaluates, passing or not.
+// Writes are serialized, so w may be shared between goroutines.
+func WithTrace(w io.Writer) Option {
+	var mu sync.Mutex
+	return WithTraceFunc(func(e TraceEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		fmt.Fprintln(w, e.String())
+	})
+}
+
+// traceRule reports the outcome of the rule at index i to the trace function.
+func (v *Validator) traceRule(name string, i int, rule Rule, err *ValidationError) {
+	if err != nil && err.MessageKey == "" {
+		err = nil
+	}
+	e := TraceEvent{Validations: name, Index: i, Err: err}
+	if _, eager := rule.(*ValidationError); !eager && rule != nil {
+		e.Rule = ruleName(rule)
+	}
+	v.trace(e)
+}
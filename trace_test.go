@@ -0,0 +1,93 @@
+package rapidval
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type traceStruct struct {
+	Name string
+}
+
+func (t *traceStruct) Validations() P {
+	return P{
+		Required("Name", t.Name),
+		MaxLength("Name", t.Name, 10),
+		Named("unique_name", RuleFunc(func(ctx context.Context) *ValidationError {
+			if t.Name == "taken" {
+				return &ValidationError{Field: "Name", MessageKey: "validation.unique"}
+			}
+			return nil
+		})),
+	}
+}
+
+func TestWithTrace(t *testing.T) {
+	tests := []struct {
+		name string
+		val  Validateable
+		want []string
+	}{
+		{
+			name: "passing",
+			val:  &traceStruct{Name: "free"},
+			want: []string{
+				"rapidval.traceStruct.Validations[0]: ok",
+				"rapidval.traceStruct.Validations[1]: ok",
+				"rapidval.traceStruct.Validations[2] unique_name: ok",
+			},
+		},
+		{
+			name: "failing",
+			val:  &traceStruct{Name: "taken"},
+			want: []string{
+				"rapidval.traceStruct.Validations[0]: ok",
+				"rapidval.traceStruct.Validations[1]: ok",
+				"rapidval.traceStruct.Validations[2] unique_name Name: validation.unique",
+			},
+		},
+		{
+			name: "eager failure",
+			val:  &traceStruct{},
+			want: []string{
+				"rapidval.traceStruct.Validations[0] Name: validation.required",
+				"rapidval.traceStruct.Validations[1]: ok",
+				"rapidval.traceStruct.Validations[2] unique_name: ok",
+			},
+		},
+		{
+			name: "panicking validations",
+			val:  &panicValidationsStruct{},
+			want: []string{
+				"rapidval.panicValidationsStruct.Validations[-1]: validation.internal",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			_ = New(WithTrace(&buf)).Validate(tt.val)
+			got := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("trace = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithTraceFunc(t *testing.T) {
+	var events []TraceEvent
+	_ = New(WithTraceFunc(func(e TraceEvent) { events = append(events, e) })).Validate(&traceStruct{})
+
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3", len(events))
+	}
+	if events[0].Err == nil || events[0].Err.MessageKey != MsgRequired {
+		t.Errorf("events[0].Err = %v, want %v", events[0].Err, MsgRequired)
+	}
+	if events[2].Rule != "unique_name" || events[2].Err != nil {
+		t.Errorf("events[2] = %+v, want passing unique_name", events[2])
+	}
+}
@@ -2,17 +2,156 @@ package rapidval
 
 import (
 	"bytes"
+	"fmt"
+	"sort"
+	"sync"
 	"text/template"
 )
 
+// DefaultLocale is the locale of the built-in default messages.
+const DefaultLocale = "tr"
+
 var defaultMessages = map[string]string{
-	MsgRequired:        "{{.Field}} alanı zorunludur",
-	MsgInvalidEmail:    "{{.Field}} geçerli bir email adresi olmalıdır",
-	MsgMinLength:       "{{.Field}} en az {{.Min}} karakter olmalıdır",
-	MsgMaxLength:       "{{.Field}} en fazla {{.Max}} karakter olmalıdır",
-	MsgBetween:         "{{.Field}} {{.Min}} ile {{.Max}} arasında olmalıdır",
-	MsgDateGreaterThan: "{{.Field}} {{.Min}} tarihinden sonra olmalıdır",
-	MsgDateLessThan:    "{{.Field}} {{.Max}} tarihinden önce olmalıdır",
+	MsgRequired:             "{{.Field}} alanı zorunludur",
+	MsgInvalidEmail:         "{{.Field}} geçerli bir email adresi olmalıdır",
+	MsgMinLength:            "{{.Field}} en az {{.Min}} karakter olmalıdır",
+	MsgMaxLength:            "{{.Field}} en fazla {{.Max}} karakter olmalıdır",
+	MsgBetween:              "{{.Field}} {{.Min}} ile {{.Max}} arasında olmalıdır",
+	MsgDateGreaterThan:      "{{.Field}} {{.Min}} tarihinden sonra olmalıdır",
+	MsgDateLessThan:         "{{.Field}} {{.Max}} tarihinden önce olmalıdır",
+	MsgMin:                  "{{.Field}} en az {{.Min}} olmalıdır",
+	MsgMax:                  "{{.Field}} en fazla {{.Max}} olmalıdır",
+	MsgOneOf:                "{{.Field}} şu değerlerden biri olmalıdır: {{.Allowed}}",
+	MsgInvalidType:          "{{.Field}} {{.Type}} türünde olmalıdır",
+	MsgInvalidJSON:          "{{.Field}} geçerli bir JSON olmalıdır",
+	MsgMaxCount:             "{{.Field}} en fazla {{.Max}} kez belirtilebilir",
+	MsgMinItems:             "{{.Field}} en az {{.Min}} öğe içermelidir",
+	MsgMaxItems:             "{{.Field}} en fazla {{.Max}} öğe içerebilir",
+	MsgInternal:             "Doğrulama sırasında beklenmeyen bir hata oluştu",
+	MsgNotBlank:             "{{.Field}} alanı boş bırakılamaz",
+	MsgUnavailable:          "{{.Field}} şu anda doğrulanamıyor, lütfen daha sonra tekrar deneyin",
+	MsgTimeout:              "Doğrulama zaman aşımına uğradı, lütfen daha sonra tekrar deneyin",
+	MsgContainsAny:          "{{.Field}} şunlardan en az birini içermelidir: {{.Allowed}}",
+	MsgContainsAll:          "{{.Field}} şunları da içermelidir: {{.Missing}}",
+	MsgLeadingTrailingSpace: "{{.Field}} boşluk ile başlayamaz veya bitemez",
+	MsgSingleLine:           "{{.Field}} tek satır olmalıdır",
+	MsgMaxRepeats:           "{{.Field}} aynı karakteri art arda en fazla {{.Max}} kez içerebilir",
+	MsgInvalidCardExpiry:    "{{.Field}} geçerli bir son kullanma ayı olmalıdır",
+	MsgCardExpired:          "{{.Field}} son kullanma tarihi ({{.Value}}) geçmiş",
+	MsgInvalidCVC:           "{{.Field}} {{if eq .Min .Max}}{{.Max}}{{else}}{{.Min}}-{{.Max}}{{end}} haneli bir güvenlik kodu olmalıdır",
+	MsgInvalidGTIN:          "{{.Field}} geçerli bir barkod numarası olmalıdır",
+	MsgInvalidISBN10:        "{{.Field}} geçerli bir 10 haneli ISBN olmalıdır",
+	MsgInvalidISBN13:        "{{.Field}} geçerli bir 13 haneli ISBN olmalıdır",
+	MsgInvalidISSN:          "{{.Field}} geçerli bir ISSN olmalıdır",
+	MsgInvalidVIN:           "{{.Field}} geçerli bir şasi numarası (VIN) olmalıdır",
+	MsgInvalidLicensePlate:  "{{.Field}} geçerli bir {{.Region}} plakası olmalıdır",
+	MsgOutsideArea:          "{{.Field}} hizmet bölgesi içinde olmalıdır",
+	MsgInvalidColor:         "{{.Field}} geçerli bir hex renk kodu olmalıdır",
+	MsgContrastRatio:        "{{.Field}} arka plan ile en az {{.Min}}:1 kontrast oranına sahip olmalıdır",
+	MsgInvalidDigest:        "{{.Field}} {{.Length}} karakterlik onaltılık bir özet olmalıdır",
+	MsgInvalidETag:          "{{.Field}} geçerli bir ETag olmalıdır",
+	MsgInvalidPasswordHash:  "{{.Field}} geçerli bir parola özeti olmalıdır",
+	MsgInvalidBucketName:    "{{.Field}} geçerli bir depolama kovası adı olmalıdır",
+	MsgInvalidObjectKey:     "{{.Field}} geçerli bir nesne anahtarı olmalıdır",
+	MsgInvalidDNSLabel:      "{{.Field}} en fazla {{.Max}} karakterlik geçerli bir DNS etiketi olmalıdır",
+	MsgInvalidDNSSubdomain:  "{{.Field}} en fazla {{.Max}} karakterlik geçerli bir DNS alt alan adı olmalıdır",
+	MsgInvalidLabelValue:    "{{.Field}} en fazla {{.Max}} karakterlik geçerli bir etiket değeri olmalıdır",
+	MsgInvalidHTTPMethod:    "{{.Field}} geçerli bir HTTP metodu olmalıdır",
+	MsgInvalidMIMEType:      "{{.Field}} geçerli bir MIME türü olmalıdır",
+	MsgInvalidLanguageTag:   "{{.Field}} geçerli bir dil etiketi olmalıdır",
+	MsgInvalidUsername:      "{{.Field}} yalnızca harf, rakam ve ayraç içerebilir, rakam veya ayraç ile başlayamaz",
+	MsgReservedUsername:     "{{.Field}} olarak bu ad kullanılamaz",
+	MsgInvalidNumericCode:   "{{.Field}} {{.Length}} haneli bir kod olmalıdır",
+	MsgAmountBetween:        "{{.Field}} {{.Min}} ile {{.Max}} arasında olmalıdır",
+	MsgCurrencyMismatch:     "{{.Field}} para birimi {{.Allowed}} olmalıdır",
+	MsgInvalidTaxRate:       "{{.Field}} {{.Jurisdiction}} için geçerli bir vergi oranı olmalıdır",
+	MsgInvalidSort:          "{{.Field}} içinde geçersiz sıralama alanı: {{.Token}}",
+	MsgInvalidFilter:        "{{.Field}} içinde geçersiz filtre ifadesi: {{.Token}}",
+	MsgInvalidFieldMask:     "{{.Field}} içinde geçersiz alan yolu: {{.Token}}",
+	MsgMaxDepth:             "Belge en fazla {{.Max}} seviye iç içe olabilir",
+	MsgMaxElements:          "Belge en fazla {{.Max}} öğe içerebilir",
+	MsgMaxStringBytes:       "Belgedeki metinler toplam en fazla {{.Max}} bayt olabilir",
+	MsgSumAtMost:            "{{.Field}} toplamı en fazla {{.Max}} olabilir",
+	MsgAscending:            "{{.Field}} önceki değerden küçük olamaz",
+	MsgOverlap:              "{{.Field}} başka bir aralık ile çakışıyor",
+	MsgRangeWithin:          "{{.Field}} {{.Min}} ile {{.Max}} arasında olmalıdır",
+	MsgPattern:              "{{.Field}} geçerli bir biçimde olmalıdır",
+	MsgInvalidChecksum:      "{{.Field}} kontrol hanesi hatalı",
+	MsgInvalidURL:           "{{.Field}} geçerli bir URL olmalıdır{{with .Allowed}} ({{range $i, $s := .}}{{if $i}}, {{end}}{{$s}}{{end}}){{end}}",
+	MsgForbidden:            "{{.Field}} için yetkiniz bulunmamaktadır",
+	MsgNotFound:             "{{.Field}} bulunamadı",
+	MsgInvalidUUID:          "{{.Field}} geçerli bir UUID olmalıdır",
+	MsgEqualField:           "{{.Field}} {{.OtherField}} ile aynı olmalıdır",
+	MsgGreaterThanField:     "{{.Field}} {{.OtherField}} değerinden büyük olmalıdır",
+	MsgDateAfterField:       "{{.Field}} {{.OtherField}} tarihinden sonra olmalıdır",
+	MsgInvalidPhone:         "{{.Field}} geçerli bir {{with .Region}}{{.}} {{end}}telefon numarası olmalıdır",
+	MsgNumeric:              "{{.Field}} yalnızca rakamlardan oluşmalıdır",
+	MsgAlpha:                "{{.Field}} yalnızca harflerden oluşmalıdır",
+	MsgAlphanumeric:         "{{.Field}} yalnızca harf ve rakamlardan oluşmalıdır",
+	MsgInvalidHTTPStatus:    "{{.Field}} geçerli bir HTTP durum kodu olmalıdır{{with .Allowed}} ({{range $i, $c := .}}{{if $i}}, {{end}}{{$c}}{{end}}){{end}}",
+}
+
+// registered holds the message keys added by RegisterMessageKey with their default templates per locale.
+var registered = struct {
+	sync.RWMutex
+	messages map[string]map[string]string
+}{messages: map[string]map[string]string{}}
+
+// RegisterMessageKey adds a message key used by a third-party rule package, together with its
+// default templates keyed by locale (e.g. "tr", "en"). Registered keys are reported by MessageKeys
+// and Translator.Missing, and their DefaultLocale template is included in NewTranslator.
+//
+// It is meant to be called from init functions and panics if key is already in use.
+func RegisterMessageKey(key string, defaults map[string]string) {
+	registered.Lock()
+	defer registered.Unlock()
+	if _, builtin := defaultMessages[key]; builtin {
+		panic(fmt.Sprintf("rapidval: message key %q is already registered", key))
+	}
+	if _, dup := registered.messages[key]; dup {
+		panic(fmt.Sprintf("rapidval: message key %q is already registered", key))
+	}
+	templates := make(map[string]string, len(defaults))
+	for locale, msg := range defaults {
+		template.Must(template.New(key).Parse(msg))
+		templates[locale] = msg
+	}
+	registered.messages[key] = templates
+}
+
+// MessageKeys returns the message keys used by the built-in rules and those added with RegisterMessageKey, in sorted order.
+func MessageKeys() []string {
+	registered.RLock()
+	defer registered.RUnlock()
+	keys := make([]string, 0, len(defaultMessages)+len(registered.messages))
+	for key := range defaultMessages {
+		keys = append(keys, key)
+	}
+	for key := range registered.messages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// DefaultMessages returns the default templates available for locale: the built-in messages for
+// DefaultLocale, and the templates registered for locale with RegisterMessageKey.
+// The result is a new map that can be modified and passed to NewTranslatorWithMessages.
+func DefaultMessages(locale string) map[string]string {
+	registered.RLock()
+	defer registered.RUnlock()
+	messages := make(map[string]string, len(defaultMessages)+len(registered.messages))
+	if locale == DefaultLocale {
+		for key, msg := range defaultMessages {
+			messages[key] = msg
+		}
+	}
+	for key, templates := range registered.messages {
+		if msg, ok := templates[locale]; ok {
+			messages[key] = msg
+		}
+	}
+	return messages
 }
 
 // Translator handles the translation of validation error messages.
@@ -20,11 +159,12 @@ var defaultMessages = map[string]string{
 type Translator struct {
 	messages map[string]string
 	tmpl     *template.Template
+	parent   *Translator
 }
 
-// NewTranslator creates a new Translator with default messages.
+// NewTranslator creates a new Translator with the default messages of DefaultLocale.
 func NewTranslator() *Translator {
-	return NewTranslatorWithMessages(defaultMessages)
+	return NewTranslatorWithMessages(DefaultMessages(DefaultLocale))
 }
 
 // NewTranslatorWithMessages creates a new Translator with custom messages.
@@ -42,20 +182,51 @@ func NewTranslatorWithMessages(messages map[string]string) *Translator {
 	return t
 }
 
+// Overlay returns a Translator that uses messages for the keys it contains and falls back to t
+// for all other keys. Overlays can be stacked, e.g. base locale, then brand, then tenant overrides,
+// without copying the underlying bundles; t is not modified.
+func (t *Translator) Overlay(messages map[string]string) *Translator {
+	o := NewTranslatorWithMessages(messages)
+	o.parent = t
+	return o
+}
+
+// Missing returns the keys reported by MessageKeys that t, including the translators it overlays,
+// has no message for. It is meant for tests checking that a locale bundle is complete.
+func (t *Translator) Missing() []string {
+	var missing []string
+	for _, key := range MessageKeys() {
+		if !t.has(key) {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+func (t *Translator) has(key string) bool {
+	for ; t != nil; t = t.parent {
+		if _, ok := t.messages[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // Translate converts a ValidationError into a human-readable message using the configured templates.
-// If the message key is not found in the templates, it returns the message key itself.
+// A template registered under "field:key", e.g. "Terms:validation.required", is used for errors of
+// that field in preference to the template for the key alone; t and the translators it overlays are
+// searched for the field-specific template first. If the message key is not found in the templates,
+// it returns the message key itself.
 func (t *Translator) Translate(err *ValidationError) string {
-	_, ok := t.messages[err.MessageKey]
-	if !ok {
-		return err.MessageKey
+	tmpl := t.lookup(err.Field + ":" + err.MessageKey)
+	if tmpl == nil {
+		tmpl = t.lookup(err.MessageKey)
 	}
-
-	var buf bytes.Buffer
-	tmpl := t.tmpl.Lookup(err.MessageKey)
 	if tmpl == nil {
 		return err.MessageKey
 	}
 
+	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, err.MessageParams); err != nil {
 		valErr, ok := err.(*ValidationError)
 		if ok {
@@ -66,3 +237,13 @@ func (t *Translator) Translate(err *ValidationError) string {
 
 	return buf.String()
 }
+
+// lookup returns the template for key from t or the nearest translator it overlays that has one.
+func (t *Translator) lookup(key string) *template.Template {
+	for ; t != nil; t = t.parent {
+		if _, ok := t.messages[key]; ok {
+			return t.tmpl.Lookup(key)
+		}
+	}
+	return nil
+}
@@ -77,3 +77,140 @@ func TestTranslatorWithCustomMessages(t *testing.T) {
 		t.Errorf("Translate() = %v, want %v", got, expected)
 	}
 }
+
+const msgRegistered = "test.registered"
+
+func init() {
+	RegisterMessageKey(msgRegistered, map[string]string{
+		DefaultLocale: "{{.Field}} kayıtlı",
+		"en":          "{{.Field}} is registered",
+	})
+}
+
+func TestMessageKeys(t *testing.T) {
+	keys := MessageKeys()
+	if len(keys) != len(defaultMessages)+1 {
+		t.Fatalf("MessageKeys() returned %d keys, want %d", len(keys), len(defaultMessages)+1)
+	}
+	for i, key := range keys {
+		if _, ok := defaultMessages[key]; !ok && key != msgRegistered {
+			t.Errorf("MessageKeys() returned unknown key %q", key)
+		}
+		if i > 0 && keys[i-1] >= key {
+			t.Errorf("MessageKeys() is not sorted at %q", key)
+		}
+	}
+}
+
+func TestRegisterMessageKey(t *testing.T) {
+	err := &ValidationError{MessageKey: msgRegistered, MessageParams: map[string]interface{}{Field: "Name"}}
+
+	if got, want := NewTranslator().Translate(err), "Name kayıtlı"; got != want {
+		t.Errorf("NewTranslator().Translate() = %v, want %v", got, want)
+	}
+	if got, want := NewTranslatorWithMessages(DefaultMessages("en")).Translate(err), "Name is registered"; got != want {
+		t.Errorf("DefaultMessages(en) translation = %v, want %v", got, want)
+	}
+
+	for _, key := range []string{MsgRequired, msgRegistered} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterMessageKey(%q) should panic for a key in use", key)
+				}
+			}()
+			RegisterMessageKey(key, nil)
+		}()
+	}
+}
+
+func TestTranslatorMissing(t *testing.T) {
+	if missing := NewTranslator().Missing(); len(missing) != 0 {
+		t.Errorf("NewTranslator().Missing() = %v, want none", missing)
+	}
+
+	en := NewTranslatorWithMessages(DefaultMessages("en"))
+	missing := en.Missing()
+	if len(missing) != len(defaultMessages) {
+		t.Errorf("Missing() = %v, want all built-in keys", missing)
+	}
+
+	overlay := NewTranslator().Overlay(map[string]string{MsgRequired: "{{.Field}} is required"})
+	if missing := overlay.Missing(); len(missing) != 0 {
+		t.Errorf("Missing() on overlay = %v, want none", missing)
+	}
+}
+
+func TestTranslatorOverlay(t *testing.T) {
+	base := NewTranslatorWithMessages(map[string]string{
+		MsgRequired:     "{{.Field}} is required",
+		MsgInvalidEmail: "{{.Field}} must be an email",
+		MsgMinLength:    "{{.Field}} is too short",
+	})
+	brand := base.Overlay(map[string]string{
+		MsgRequired:     "Please fill in {{.Field}}",
+		MsgInvalidEmail: "Please enter a valid email",
+	})
+	tenant := brand.Overlay(map[string]string{
+		MsgRequired: "{{.Field}} cannot be blank",
+	})
+
+	tests := []struct {
+		name string
+		tr   *Translator
+		key  string
+		want string
+	}{
+		{"tenant override", tenant, MsgRequired, "Name cannot be blank"},
+		{"brand override", tenant, MsgInvalidEmail, "Please enter a valid email"},
+		{"base", tenant, MsgMinLength, "Name is too short"},
+		{"unknown key", tenant, "validation.unknown", "validation.unknown"},
+		{"brand unaffected", brand, MsgRequired, "Please fill in Name"},
+		{"base unaffected", base, MsgRequired, "Name is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &ValidationError{MessageKey: tt.key, MessageParams: map[string]interface{}{Field: "Name"}}
+			if got := tt.tr.Translate(err); got != tt.want {
+				t.Errorf("Translate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslatorFieldMessages(t *testing.T) {
+	base := NewTranslatorWithMessages(map[string]string{
+		MsgRequired:                "{{.Field}} is required",
+		"Terms:" + MsgRequired:     "You must accept the terms",
+		"Email:" + MsgInvalidEmail: "Please enter your work email",
+	})
+	tenant := base.Overlay(map[string]string{
+		MsgRequired:     "{{.Field}} cannot be blank",
+		MsgInvalidEmail: "{{.Field}} must be an email",
+	})
+
+	tests := []struct {
+		name  string
+		tr    *Translator
+		field string
+		key   string
+		want  string
+	}{
+		{"field message", base, "Terms", MsgRequired, "You must accept the terms"},
+		{"other field", base, "Name", MsgRequired, "Name is required"},
+		{"field message in overlaid translator", tenant, "Terms", MsgRequired, "You must accept the terms"},
+		{"overlay for other fields", tenant, "Name", MsgRequired, "Name cannot be blank"},
+		{"field message for other key", tenant, "Email", MsgInvalidEmail, "Please enter your work email"},
+		{"field message is not a key", base, "Terms", MsgMinLength, MsgMinLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newError(tt.field, tt.key, nil)
+			if got := tt.tr.Translate(err); got != tt.want {
+				t.Errorf("Translate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
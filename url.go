@@ -0,0 +1,90 @@
+package rapidval
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// URLOption restricts the URLs accepted by URL.
+type URLOption func(*urlConfig)
+
+type urlConfig struct {
+	schemes     []string
+	requireHost bool
+	port        int // 0: optional, 1: required, -1: forbidden
+}
+
+// URLSchemes accepts only URLs with one of the given schemes, compared case-insensitively,
+// e.g. URLSchemes("https") or URLSchemes("http", "https"). The schemes are reported in the Allowed param.
+func URLSchemes(schemes ...string) URLOption {
+	return func(c *urlConfig) {
+		c.schemes = schemes
+	}
+}
+
+// URLRequireHost rejects URLs without a host, such as "mailto:" or "file:///tmp/x" URLs.
+func URLRequireHost() URLOption {
+	return func(c *urlConfig) {
+		c.requireHost = true
+	}
+}
+
+// URLRequirePort rejects URLs without an explicit port.
+func URLRequirePort() URLOption {
+	return func(c *urlConfig) {
+		c.port = 1
+	}
+}
+
+// URLForbidPort rejects URLs with an explicit port, e.g. for callbacks that must use the scheme's default port.
+func URLForbidPort() URLOption {
+	return func(c *urlConfig) {
+		c.port = -1
+	}
+}
+
+// URL validates that value is an absolute URL with a scheme. A port, if present, must be between 1 and 65535.
+// Options restrict the accepted URLs further, e.g. for webhook and callback URLs:
+//
+//	rapidval.URL("WebhookURL", h.URL, rapidval.URLSchemes("https"), rapidval.URLRequireHost())
+func URL(field string, value string, opts ...URLOption) *ValidationError {
+	var cfg urlConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if !validURL(value, &cfg) {
+		err := newError(field, MsgInvalidURL, value)
+		if len(cfg.schemes) > 0 {
+			err.MessageParams[Allowed] = cfg.schemes
+		}
+		return err
+	}
+	return nil
+}
+
+func validURL(value string, cfg *urlConfig) bool {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" {
+		return false
+	}
+	if len(cfg.schemes) > 0 {
+		allowed := false
+		for _, scheme := range cfg.schemes {
+			allowed = allowed || strings.EqualFold(scheme, u.Scheme)
+		}
+		if !allowed {
+			return false
+		}
+	}
+	if cfg.requireHost && u.Hostname() == "" {
+		return false
+	}
+	port := u.Port()
+	if port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return false
+		}
+	}
+	return cfg.port == 0 || (cfg.port > 0) == (port != "")
+}
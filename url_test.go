@@ -0,0 +1,58 @@
+package rapidval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestURL(t *testing.T) {
+	webhook := []URLOption{URLSchemes("http", "https"), URLRequireHost()}
+	tests := []struct {
+		name    string
+		value   string
+		opts    []URLOption
+		wantErr bool
+	}{
+		{"https", "https://example.com/hooks?id=1", nil, false},
+		{"mailto", "mailto:user@example.com", nil, false},
+		{"relative", "/hooks", nil, true},
+		{"no scheme", "example.com", nil, true},
+		{"empty", "", nil, true},
+		{"space in host", "http://exa mple.com", nil, true},
+		{"port out of range", "http://example.com:70000", nil, true},
+		{"port zero", "http://example.com:0", nil, true},
+		{"webhook", "https://example.com:8443/hook", webhook, false},
+		{"webhook uppercase scheme", "HTTPS://example.com", webhook, false},
+		{"webhook ftp", "ftp://example.com", webhook, true},
+		{"webhook without host", "https:///hook", webhook, true},
+		{"webhook opaque", "http:example.com", webhook, true},
+		{"port required", "https://example.com", []URLOption{URLRequirePort()}, true},
+		{"port required present", "https://example.com:443", []URLOption{URLRequirePort()}, false},
+		{"port forbidden", "https://example.com:443", []URLOption{URLForbidPort()}, true},
+		{"port forbidden absent", "https://[::1]/x", []URLOption{URLForbidPort()}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := URL("CallbackURL", tt.value, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("URL(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if err != nil && err.MessageKey != MsgInvalidURL {
+				t.Errorf("URL(%q) key = %q, want %q", tt.value, err.MessageKey, MsgInvalidURL)
+			}
+		})
+	}
+}
+
+func TestURLAllowed(t *testing.T) {
+	err := URL("CallbackURL", "ftp://example.com", URLSchemes("https"))
+	if err == nil {
+		t.Fatal("URL() = nil, want error")
+	}
+	if got := err.MessageParams[Allowed]; !reflect.DeepEqual(got, []string{"https"}) {
+		t.Errorf("Allowed = %v, want [https]", got)
+	}
+	if got, want := NewTranslator().Translate(err), "CallbackURL geçerli bir URL olmalıdır (https)"; got != want {
+		t.Errorf("Translate() = %q, want %q", got, want)
+	}
+}
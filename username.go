@@ -0,0 +1,83 @@
+package rapidval
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// UsernamePolicy configures the Username rule.
+type UsernamePolicy struct {
+	// MinLength and MaxLength bound the length in characters. Zero means no bound.
+	MinLength, MaxLength int
+
+	// Unicode allows letters and digits of any script; by default only a-z, A-Z and 0-9 are allowed.
+	Unicode bool
+
+	// Separators are the characters allowed between letters and digits, e.g. "._-". Separators cannot
+	// start or end a username or follow each other.
+	Separators string
+
+	// AllowLeadingDigit allows usernames starting with a digit.
+	AllowLeadingDigit bool
+
+	// Reserved lists names that cannot be taken, compared case-insensitively.
+	Reserved []string
+}
+
+// DefaultUsernamePolicy allows 3 to 30 ASCII letters and digits separated by '.', '_' or '-',
+// starting with a letter, and reserves common system and role names.
+var DefaultUsernamePolicy = UsernamePolicy{
+	MinLength:  3,
+	MaxLength:  30,
+	Separators: "._-",
+	Reserved:   []string{"admin", "administrator", "root", "system", "support", "help", "api", "www", "mail", "me", "null", "undefined"},
+}
+
+// Username validates a username against policy. Length violations are reported as MsgMinLength or
+// MsgMaxLength, reserved names as MsgReservedUsername and all other violations as MsgInvalidUsername.
+func Username(field string, value string, policy UsernamePolicy) *ValidationError {
+	n := utf8.RuneCountInString(value)
+	if policy.MinLength > 0 && n < policy.MinLength {
+		err := newError(field, MsgMinLength, value)
+		err.MessageParams[Min] = policy.MinLength
+		return err
+	}
+	if policy.MaxLength > 0 && n > policy.MaxLength {
+		err := newError(field, MsgMaxLength, value)
+		err.MessageParams[Max] = policy.MaxLength
+		return err
+	}
+	if !policy.allowed(value) {
+		return newError(field, MsgInvalidUsername, value)
+	}
+	for _, r := range policy.Reserved {
+		if strings.EqualFold(value, r) {
+			return newError(field, MsgReservedUsername, value)
+		}
+	}
+	return nil
+}
+
+func (p UsernamePolicy) allowed(s string) bool {
+	separator := true // disallows a leading separator
+	for i, r := range s {
+		switch {
+		case p.Separators != "" && strings.ContainsRune(p.Separators, r):
+			if separator {
+				return false
+			}
+			separator = true
+			continue
+		case p.Unicode && unicode.IsLetter(r), r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case p.Unicode && unicode.IsDigit(r), r >= '0' && r <= '9':
+			if i == 0 && !p.AllowLeadingDigit {
+				return false
+			}
+		default:
+			return false
+		}
+		separator = false
+	}
+	return !separator || s == ""
+}
@@ -0,0 +1,47 @@
+package rapidval
+
+import "testing"
+
+func TestUsername(t *testing.T) {
+	unicode := DefaultUsernamePolicy
+	unicode.Unicode = true
+	unicode.AllowLeadingDigit = true
+
+	tests := []struct {
+		name    string
+		value   string
+		policy  UsernamePolicy
+		wantKey string
+	}{
+		{"simple", "john", DefaultUsernamePolicy, ""},
+		{"separators", "john.doe_42", DefaultUsernamePolicy, ""},
+		{"too short", "jo", DefaultUsernamePolicy, MsgMinLength},
+		{"too long", "abcdefghijklmnopqrstuvwxyzabcde", DefaultUsernamePolicy, MsgMaxLength},
+		{"leading digit", "1john", DefaultUsernamePolicy, MsgInvalidUsername},
+		{"leading separator", ".john", DefaultUsernamePolicy, MsgInvalidUsername},
+		{"trailing separator", "john_", DefaultUsernamePolicy, MsgInvalidUsername},
+		{"consecutive separators", "john..doe", DefaultUsernamePolicy, MsgInvalidUsername},
+		{"space", "john doe", DefaultUsernamePolicy, MsgInvalidUsername},
+		{"non ascii", "çağrı", DefaultUsernamePolicy, MsgInvalidUsername},
+		{"reserved", "Admin", DefaultUsernamePolicy, MsgReservedUsername},
+		{"unicode letters", "çağrı", unicode, ""},
+		{"unicode leading digit", "1çağrı", unicode, ""},
+		{"no separators", "john-doe", UsernamePolicy{}, MsgInvalidUsername},
+		{"no bounds", "j", UsernamePolicy{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Username("Username", tt.value, tt.policy)
+			if tt.wantKey == "" {
+				if err != nil {
+					t.Errorf("Username(%q) = %v, want nil", tt.value, err)
+				}
+				return
+			}
+			if err == nil || err.MessageKey != tt.wantKey {
+				t.Errorf("Username(%q) = %v, want %s", tt.value, err, tt.wantKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package rapidval
+
+// UUIDOption configures the UUID validators.
+type UUIDOption func(*uuidConfig)
+
+type uuidConfig struct {
+	compact bool
+}
+
+// UUIDAllowCompact also accepts the 32-character form without hyphens, e.g. "6ba7b8109dad11d180b400c04fd430c8".
+func UUIDAllowCompact() UUIDOption {
+	return func(c *uuidConfig) {
+		c.compact = true
+	}
+}
+
+// UUID validates that value is an RFC 9562 UUID of versions 1 to 7 in the canonical 36-character form,
+// e.g. "6ba7b810-9dad-11d1-80b4-00c04fd430c8". Hex digits may be upper or lower case. The nil and max
+// UUIDs are rejected, since they do not reference anything.
+func UUID(field string, value string, opts ...UUIDOption) *ValidationError {
+	return uuid(field, value, 0, opts)
+}
+
+// UUIDv4 is like UUID but only accepts random (version 4) UUIDs.
+func UUIDv4(field string, value string, opts ...UUIDOption) *ValidationError {
+	return uuid(field, value, 4, opts)
+}
+
+// UUIDv7 is like UUID but only accepts time-ordered (version 7) UUIDs.
+func UUIDv7(field string, value string, opts ...UUIDOption) *ValidationError {
+	return uuid(field, value, 7, opts)
+}
+
+func uuid(field, value string, version byte, opts []UUIDOption) *ValidationError {
+	var cfg uuidConfig
+	if len(opts) > 0 {
+		cfg = uuidOptions(opts)
+	}
+	if !validUUID(value, version, cfg.compact) {
+		return newError(field, MsgInvalidUUID, value)
+	}
+	return nil
+}
+
+// uuidOptions applies opts. It is only called with options, since the config escapes to them.
+func uuidOptions(opts []UUIDOption) uuidConfig {
+	var cfg uuidConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// validUUID checks the layout, the version nibble and the RFC 9562 variant (0b10xx) of value.
+// version 0 accepts versions 1 to 7.
+func validUUID(value string, version byte, compact bool) bool {
+	var hex [32]byte
+	switch {
+	case len(value) == 36:
+		n := 0
+		for i := 0; i < 36; i++ {
+			if i == 8 || i == 13 || i == 18 || i == 23 {
+				if value[i] != '-' {
+					return false
+				}
+				continue
+			}
+			hex[n] = value[i]
+			n++
+		}
+	case len(value) == 32 && compact:
+		copy(hex[:], value)
+	default:
+		return false
+	}
+	if !isHex(string(hex[:])) {
+		return false
+	}
+	v, _ := hexDigit(hex[12])
+	if version == 0 && (v < 1 || v > 7) || version != 0 && v != version {
+		return false
+	}
+	variant, _ := hexDigit(hex[16])
+	return variant&0xc == 0x8
+}
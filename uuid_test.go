@@ -0,0 +1,53 @@
+package rapidval
+
+import "testing"
+
+func TestUUID(t *testing.T) {
+	const (
+		v1 = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+		v4 = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+		v7 = "01890a5d-ac96-774b-bcce-b302099a8057"
+	)
+	compact := []UUIDOption{UUIDAllowCompact()}
+
+	tests := []struct {
+		name  string
+		check func(field, value string, opts ...UUIDOption) *ValidationError
+		value string
+		opts  []UUIDOption
+		valid bool
+	}{
+		{"v1", UUID, v1, nil, true},
+		{"v4", UUID, v4, nil, true},
+		{"v7", UUID, v7, nil, true},
+		{"upper case", UUID, "F47AC10B-58CC-4372-A567-0E02B2C3D479", nil, true},
+		{"version 8", UUID, "f47ac10b-58cc-8372-a567-0e02b2c3d479", nil, false},
+		{"version 0", UUID, "f47ac10b-58cc-0372-a567-0e02b2c3d479", nil, false},
+		{"nil", UUID, "00000000-0000-0000-0000-000000000000", nil, false},
+		{"max", UUID, "ffffffff-ffff-ffff-ffff-ffffffffffff", nil, false},
+		{"microsoft variant", UUID, "f47ac10b-58cc-4372-c567-0e02b2c3d479", nil, false},
+		{"misplaced hyphen", UUID, "f47ac10b5-8cc-4372-a567-0e02b2c3d479", nil, false},
+		{"not hex", UUID, "g47ac10b-58cc-4372-a567-0e02b2c3d479", nil, false},
+		{"braces", UUID, "{f47ac10b-58cc-4372-a567-0e02b2c3d479}", nil, false},
+		{"empty", UUID, "", nil, false},
+		{"compact not allowed", UUID, "f47ac10b58cc4372a5670e02b2c3d479", nil, false},
+		{"compact", UUID, "f47ac10b58cc4372a5670e02b2c3d479", compact, true},
+		{"canonical with compact", UUID, v4, compact, true},
+		{"v4 only", UUIDv4, v4, nil, true},
+		{"v4 rejects v7", UUIDv4, v7, nil, false},
+		{"v7 only", UUIDv7, v7, nil, true},
+		{"v7 rejects v1", UUIDv7, v1, nil, false},
+		{"v7 compact", UUIDv7, "01890a5dac96774bbcceb302099a8057", compact, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.check("ID", tt.value, tt.opts...)
+			if (err == nil) != tt.valid {
+				t.Fatalf("error = %v, want valid %v", err, tt.valid)
+			}
+			if err != nil && err.MessageKey != MsgInvalidUUID {
+				t.Errorf("key = %q, want %q", err.MessageKey, MsgInvalidUUID)
+			}
+		})
+	}
+}